@@ -0,0 +1,98 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func (c *Client) CreateCronJob(ctx context.Context, manifest types.CronJobManifest) (*types.CronJob, error) {
+	_, resp, err := c.postJSON(ctx, "/cronjobs", manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.CronJob{})
+}
+
+func (c *Client) UpdateCronJob(ctx context.Context, id string, manifest types.CronJobManifest) (*types.CronJob, error) {
+	_, resp, err := c.putJSON(ctx, fmt.Sprintf("/cronjobs/%s", id), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.CronJob{})
+}
+
+func (c *Client) GetCronJob(ctx context.Context, id string) (*types.CronJob, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/cronjobs/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.CronJob{})
+}
+
+func (c *Client) ListCronJobs(ctx context.Context) (result types.CronJobList, _ error) {
+	defer func() {
+		sort.Slice(result.Items, func(i, j int) bool {
+			return result.Items[i].Metadata.Created.Time.Before(result.Items[j].Metadata.Created.Time)
+		})
+	}()
+
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/cronjobs", nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return result, err
+}
+
+func (c *Client) DeleteCronJob(ctx context.Context, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/cronjobs/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ExecuteCronJob starts a run of the cron job's workflow now, outside of its schedule.
+func (c *Client) ExecuteCronJob(ctx context.Context, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/cronjobs/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *Client) SuspendCronJob(ctx context.Context, id string) (*types.CronJob, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/cronjobs/%s/actions/suspend", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.CronJob{})
+}
+
+func (c *Client) ResumeCronJob(ctx context.Context, id string) (*types.CronJob, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/cronjobs/%s/actions/resume", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.CronJob{})
+}
@@ -49,3 +49,23 @@ func (c *Client) DeleteEmailReceiver(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (c *Client) SuspendEmailReceiver(ctx context.Context, id string) (*types.EmailReceiver, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/email-receivers/%s/actions/suspend", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.EmailReceiver{})
+}
+
+func (c *Client) ResumeEmailReceiver(ctx context.Context, id string) (*types.EmailReceiver, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/email-receivers/%s/actions/resume", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.EmailReceiver{})
+}
@@ -0,0 +1,60 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func (c *Client) CreateNotificationTarget(ctx context.Context, manifest types.NotificationTargetManifest) (*types.NotificationTarget, error) {
+	_, resp, err := c.postJSON(ctx, "/notification-targets", manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.NotificationTarget{})
+}
+
+func (c *Client) UpdateNotificationTarget(ctx context.Context, id string, manifest types.NotificationTargetManifest) (*types.NotificationTarget, error) {
+	_, resp, err := c.putJSON(ctx, fmt.Sprintf("/notification-targets/%s", id), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.NotificationTarget{})
+}
+
+func (c *Client) GetNotificationTarget(ctx context.Context, id string) (*types.NotificationTarget, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/notification-targets/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.NotificationTarget{})
+}
+
+func (c *Client) ListNotificationTargets(ctx context.Context) (result types.NotificationTargetList, _ error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/notification-targets", nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return result, err
+}
+
+func (c *Client) DeleteNotificationTarget(ctx context.Context, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/notification-targets/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
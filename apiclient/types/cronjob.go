@@ -9,11 +9,40 @@ type CronJob struct {
 }
 
 type CronJobManifest struct {
-	Description  string    `json:"description,omitempty"`
-	Schedule     string    `json:"schedule,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Schedule is a cron expression with optional seconds, such as "0 9 * * 1-5", or a descriptor such as "@daily" or
+	// "@every 1h30m". It can start with "CRON_TZ=<timezone>" to set its own timezone.
+	Schedule string `json:"schedule,omitempty"`
+	// Timezone is the IANA timezone the schedule is evaluated in, such as "America/New_York". The default is the
+	// server's timezone.
+	Timezone     string    `json:"timezone,omitempty"`
 	Workflow     string    `json:"workflow,omitempty"`
 	Input        string    `json:"input,omitempty"`
 	TaskSchedule *Schedule `json:"taskSchedule,omitempty"`
+	// ConcurrencyPolicy decides what happens when a run is due while an execution of the cron job is still running.
+	// The default is Allow.
+	ConcurrencyPolicy CronJobConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	// StartingDeadline is how late a run can start after its scheduled time, such as "10m". Later runs are skipped.
+	// By default, late runs always start.
+	StartingDeadline string `json:"startingDeadline,omitempty"`
+	// CatchUp starts a run for each scheduled time that was missed, such as while the server was down, instead of a
+	// single run for all of them. At most the 10 most recent missed runs are started.
+	CatchUp bool `json:"catchUp,omitempty"`
+	// Suspended stops scheduled runs. The runs missed while suspended are not caught up. It is changed with the suspend
+	// and resume actions, and updates of the cron job keep its current value.
+	Suspended bool `json:"suspended,omitempty"`
 }
 
+type CronJobConcurrencyPolicy string
+
+const (
+	// CronJobConcurrencyPolicyAllow starts runs even if previous executions are still running.
+	CronJobConcurrencyPolicyAllow CronJobConcurrencyPolicy = "Allow"
+	// CronJobConcurrencyPolicyForbid skips runs while a previous execution is still running. Blocked executions, such as
+	// those waiting for an approval, do not count as running.
+	CronJobConcurrencyPolicyForbid CronJobConcurrencyPolicy = "Forbid"
+	// CronJobConcurrencyPolicyReplace deletes the running executions, but not blocked ones, and starts the new run.
+	CronJobConcurrencyPolicyReplace CronJobConcurrencyPolicy = "Replace"
+)
+
 type CronJobList List[CronJob]
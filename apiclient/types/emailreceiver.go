@@ -13,6 +13,32 @@ type EmailReceiverManifest struct {
 	User           string   `json:"user,omitempty"`
 	Workflow       string   `json:"workflow"`
 	AllowedSenders []string `json:"allowedSenders,omitempty"`
+	// AuthenticationPolicy decides what happens to email that is not authenticated by SPF or DKIM aligned with the
+	// domain of its From header. The default is flag.
+	AuthenticationPolicy EmailAuthenticationPolicy `json:"authenticationPolicy,omitempty"`
+	// Suspended stops the receiver from starting its workflow. Received emails are dropped. It is changed with the
+	// suspend and resume actions, and updates of the receiver keep its current value.
+	Suspended bool `json:"suspended,omitempty"`
+	// MaxAttachmentSize is the largest attachment, in bytes, that is written to the workspace of the workflow
+	// execution. Larger attachments are listed in the input but not written. The default is 10MB.
+	MaxAttachmentSize int64 `json:"maxAttachmentSize,omitempty"`
+	// MaxTotalAttachmentSize is the most bytes of attachments that are written for one email. The default is 25MB.
+	MaxTotalAttachmentSize int64 `json:"maxTotalAttachmentSize,omitempty"`
+	// ReplyWithOutput sends the output of the workflow back to the sender when the execution completes. Replies to
+	// that email continue the same thread. The reply goes to the From header address, and only if the email is
+	// authenticated and that address is an allowed sender. The server must be configured with an outbound SMTP relay.
+	ReplyWithOutput bool `json:"replyWithOutput,omitempty"`
 }
 
+type EmailAuthenticationPolicy string
+
+const (
+	// EmailAuthenticationPolicyFlag starts the workflow for email that is not authenticated. The results of the SPF,
+	// DKIM and DMARC checks are in the input of the workflow either way.
+	EmailAuthenticationPolicyFlag EmailAuthenticationPolicy = "flag"
+	// EmailAuthenticationPolicyReject drops email that is not authenticated. The allowed senders must match both the
+	// envelope sender and the From header.
+	EmailAuthenticationPolicyReject EmailAuthenticationPolicy = "reject"
+)
+
 type EmailReceiverList List[EmailReceiver]
@@ -0,0 +1,54 @@
+package types
+
+type NotificationTarget struct {
+	Metadata
+	NotificationTargetManifest
+	LastDeliveryAt    *Time  `json:"lastDeliveryAt,omitempty"`
+	LastDeliveryError string `json:"lastDeliveryError,omitempty"`
+}
+
+type NotificationTargetManifest struct {
+	Description string `json:"description,omitempty"`
+	// URL is where notifications are sent with a POST request.
+	URL string `json:"url"`
+	// Headers are added to each notification request.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Secret signs notifications. The X-Obot-Signature header is "sha256=" and the hex HMAC-SHA256 of
+	// "<timestamp>.<body>", where the timestamp is the X-Obot-Timestamp header.
+	Secret string `json:"secret,omitempty"`
+	// Events are the events sent to the target. All events are sent if empty.
+	Events []NotificationEvent `json:"events,omitempty"`
+}
+
+type NotificationTargetList List[NotificationTarget]
+
+type NotificationEvent string
+
+const (
+	// NotificationEventComplete is sent when a workflow execution completes, or when a run that is not part of a
+	// workflow execution completes.
+	NotificationEventComplete NotificationEvent = "complete"
+	// NotificationEventError is sent when a workflow execution fails, or when a run that is not part of a workflow
+	// execution fails.
+	NotificationEventError NotificationEvent = "error"
+	// NotificationEventBlocked is sent when a workflow execution is waiting for approval.
+	NotificationEventBlocked NotificationEvent = "blocked"
+	// NotificationEventNeedsAuth is sent when a run is waiting for the user to log in to a tool.
+	NotificationEventNeedsAuth NotificationEvent = "needsAuth"
+)
+
+// NotificationPayload is the JSON body of a notification.
+type NotificationPayload struct {
+	ID                  string            `json:"id"`
+	Event               NotificationEvent `json:"event"`
+	Time                Time              `json:"time"`
+	WorkflowID          string            `json:"workflowID,omitempty"`
+	WorkflowExecutionID string            `json:"workflowExecutionID,omitempty"`
+	AgentID             string            `json:"agentID,omitempty"`
+	ThreadID            string            `json:"threadID,omitempty"`
+	RunID               string            `json:"runID,omitempty"`
+	State               string            `json:"state,omitempty"`
+	Output              string            `json:"output,omitempty"`
+	Error               string            `json:"error,omitempty"`
+	AuthURL             string            `json:"authURL,omitempty"`
+}
@@ -5,23 +5,38 @@ import (
 )
 
 type Run struct {
-	ID                string `json:"id,omitempty"`
-	Created           Time   `json:"created,omitempty"`
-	ThreadID          string `json:"threadID,omitempty"`
-	AgentID           string `json:"agentID,omitempty"`
-	WorkflowID        string `json:"workflowID,omitempty"`
-	WorkflowStepID    string `json:"workflowStepID,omitempty"`
-	SubCallWorkflowID string `json:"subCallWorkflowID,omitempty"`
-	SubCallInput      string `json:"subCallInput,omitempty"`
-	PreviousRunID     string `json:"previousRunID,omitempty"`
-	Input             string `json:"input"`
-	State             string `json:"state,omitempty"`
-	Output            string `json:"output,omitempty"`
-	Error             string `json:"error,omitempty"`
+	ID                string     `json:"id,omitempty"`
+	Created           Time       `json:"created,omitempty"`
+	ThreadID          string     `json:"threadID,omitempty"`
+	AgentID           string     `json:"agentID,omitempty"`
+	WorkflowID        string     `json:"workflowID,omitempty"`
+	WorkflowStepID    string     `json:"workflowStepID,omitempty"`
+	SubCallWorkflowID string     `json:"subCallWorkflowID,omitempty"`
+	SubCallInput      string     `json:"subCallInput,omitempty"`
+	PreviousRunID     string     `json:"previousRunID,omitempty"`
+	Input             string     `json:"input"`
+	State             string     `json:"state,omitempty"`
+	Output            string     `json:"output,omitempty"`
+	Error             string     `json:"error,omitempty"`
+	TokenUsage        TokenUsage `json:"tokenUsage,omitempty"`
 }
 
 type RunList List[Run]
 
+type TokenUsage struct {
+	PromptTokens     int `json:"promptTokens,omitempty"`
+	CompletionTokens int `json:"completionTokens,omitempty"`
+	TotalTokens      int `json:"totalTokens,omitempty"`
+}
+
+func (u TokenUsage) Add(other TokenUsage) TokenUsage {
+	return TokenUsage{
+		PromptTokens:     u.PromptTokens + other.PromptTokens,
+		CompletionTokens: u.CompletionTokens + other.CompletionTokens,
+		TotalTokens:      u.TotalTokens + other.TotalTokens,
+	}
+}
+
 // +k8s:deepcopy-gen=false
 
 // +k8s:openapi-gen=false
@@ -35,6 +35,8 @@ type Schedule struct {
 	Minute   int    `json:"minute"`
 	Day      int    `json:"day"`
 	Weekday  int    `json:"weekday"`
+	// Timezone is the IANA timezone of the hour, such as "Europe/Berlin". The default is the server's timezone.
+	Timezone string `json:"timezone,omitempty"`
 }
 
 type TaskStep struct {
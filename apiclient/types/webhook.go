@@ -16,6 +16,129 @@ type WebhookManifest struct {
 	Headers          []string `json:"headers"`
 	Secret           string   `json:"secret"`
 	ValidationHeader string   `json:"validationHeader"`
+	// Suspended stops the webhook from starting its workflow. Deliveries get 503 Service Unavailable. It is changed with
+	// the suspend and resume actions, and updates of the webhook keep its current value.
+	Suspended bool `json:"suspended,omitempty"`
+	// SignatureScheme is how deliveries are signed with the secret. The default is an HMAC-SHA256 of the body in the
+	// validation header.
+	SignatureScheme WebhookSignatureScheme `json:"signatureScheme,omitempty"`
+	// SignatureEncoding is the encoding of the signature for the hmac and timestampedHMAC schemes. The default is hex.
+	SignatureEncoding WebhookSignatureEncoding `json:"signatureEncoding,omitempty"`
+	// TimestampHeader is the header with the delivery timestamp for the timestampedHMAC scheme.
+	TimestampHeader string `json:"timestampHeader,omitempty"`
+	// ReplayWindow is how old the timestamp of a signed delivery can be, such as "5m". The default is 5m.
+	ReplayWindow string `json:"replayWindow,omitempty"`
+	// Filter is a CEL expression evaluated against the "payload" and "headers" of a delivery. Deliveries that do not
+	// match are accepted without starting the workflow.
+	Filter string `json:"filter,omitempty"`
+	// Mapping maps the fields of the workflow input to CEL expressions evaluated against the "payload" and "headers" of
+	// a delivery. If set, the workflow input is only the mapped fields instead of the whole delivery.
+	Mapping map[string]string `json:"mapping,omitempty"`
+	// Synchronous makes the webhook wait for the workflow to finish and respond with its output.
+	Synchronous bool `json:"synchronous,omitempty"`
+	// SynchronousTimeout is the longest a synchronous webhook waits for the workflow, such as "30s". If the workflow has
+	// not finished by then, the webhook responds with 202 Accepted. The default is 30s.
+	SynchronousTimeout string `json:"synchronousTimeout,omitempty"`
+	// RateLimit limits how often the webhook accepts deliveries. Deliveries over the limit get 429 Too Many Requests.
+	RateLimit *WebhookRateLimit `json:"rateLimit,omitempty"`
+	// IdempotencyHeader is the header with the unique ID of a delivery, such as "X-GitHub-Delivery". A delivery with the
+	// same ID as an accepted delivery in the idempotency window is acknowledged without starting the workflow again.
+	IdempotencyHeader string `json:"idempotencyHeader,omitempty"`
+	// IdempotencyField is the dotted path of the unique ID of a delivery in the JSON payload, such as "event.id". It is
+	// used if IdempotencyHeader is not set.
+	IdempotencyField string `json:"idempotencyField,omitempty"`
+	// IdempotencyWindow is how long a delivery ID is remembered, such as "1h". The default is 24h. Deliveries are not
+	// kept for more than 7 days.
+	IdempotencyWindow string `json:"idempotencyWindow,omitempty"`
+}
+
+type WebhookRateLimit struct {
+	// Requests is the number of deliveries accepted per period.
+	Requests int `json:"requests,omitempty"`
+	// Period is the period of the rate limit, such as "1m". The default is 1m.
+	Period string `json:"period,omitempty"`
+}
+
+// SignatureHeader returns the header with the delivery signature, which defaults to the header of the signature scheme.
+func (m WebhookManifest) SignatureHeader() string {
+	if m.ValidationHeader != "" {
+		return m.ValidationHeader
+	}
+	switch m.SignatureScheme {
+	case WebhookSignatureSchemeGitHub:
+		return "X-Hub-Signature-256"
+	case WebhookSignatureSchemeSlack:
+		return "X-Slack-Signature"
+	case WebhookSignatureSchemeStripe:
+		return "Stripe-Signature"
+	}
+	return ""
 }
 
 type WebhookList List[Webhook]
+
+type WebhookSignatureScheme string
+
+const (
+	// WebhookSignatureSchemeHMAC is an HMAC-SHA256 of the body sent as "sha256=<signature>" or just the signature.
+	WebhookSignatureSchemeHMAC WebhookSignatureScheme = "hmac"
+	// WebhookSignatureSchemeGitHub is the X-Hub-Signature-256 header sent by GitHub.
+	WebhookSignatureSchemeGitHub WebhookSignatureScheme = "github"
+	// WebhookSignatureSchemeSlack is the X-Slack-Signature header sent by Slack, an HMAC-SHA256 of
+	// "v0:<timestamp>:<body>" with the timestamp in the X-Slack-Request-Timestamp header.
+	WebhookSignatureSchemeSlack WebhookSignatureScheme = "slack"
+	// WebhookSignatureSchemeStripe is the Stripe-Signature header sent by Stripe, "t=<timestamp>,v1=<signature>" with
+	// an HMAC-SHA256 of "<timestamp>.<body>".
+	WebhookSignatureSchemeStripe WebhookSignatureScheme = "stripe"
+	// WebhookSignatureSchemeTimestampedHMAC is an HMAC-SHA256 of "<timestamp>.<body>" with the unix timestamp in the
+	// timestamp header.
+	WebhookSignatureSchemeTimestampedHMAC WebhookSignatureScheme = "timestampedHMAC"
+)
+
+type WebhookSignatureEncoding string
+
+const (
+	WebhookSignatureEncodingHex    WebhookSignatureEncoding = "hex"
+	WebhookSignatureEncodingBase64 WebhookSignatureEncoding = "base64"
+)
+
+type WebhookDeliveryResult string
+
+const (
+	WebhookDeliveryResultAccepted         WebhookDeliveryResult = "Accepted"
+	WebhookDeliveryResultFiltered         WebhookDeliveryResult = "Filtered"
+	WebhookDeliveryResultInvalidSignature WebhookDeliveryResult = "InvalidSignature"
+	WebhookDeliveryResultInvalidToken     WebhookDeliveryResult = "InvalidToken"
+	WebhookDeliveryResultFailed           WebhookDeliveryResult = "Failed"
+	WebhookDeliveryResultDuplicate        WebhookDeliveryResult = "Duplicate"
+	WebhookDeliveryResultSuspended        WebhookDeliveryResult = "Suspended"
+)
+
+// IsRejected returns true if the delivery failed the signature or token check of the webhook.
+func (in WebhookDeliveryResult) IsRejected() bool {
+	return in == WebhookDeliveryResultInvalidSignature || in == WebhookDeliveryResultInvalidToken
+}
+
+type WebhookDelivery struct {
+	Metadata
+	WebhookID           string                `json:"webhookID,omitempty"`
+	Headers             map[string]string     `json:"headers,omitempty"`
+	BodySize            int                   `json:"bodySize,omitempty"`
+	BodySHA256          string                `json:"bodySHA256,omitempty"`
+	Result              WebhookDeliveryResult `json:"result,omitempty"`
+	StatusCode          int                   `json:"statusCode,omitempty"`
+	Error               string                `json:"error,omitempty"`
+	WorkflowExecutionID string                `json:"workflowExecutionID,omitempty"`
+	IdempotencyKey      string                `json:"idempotencyKey,omitempty"`
+	ReplayOf            string                `json:"replayOf,omitempty"`
+	Replayable          bool                  `json:"replayable,omitempty"`
+}
+
+type WebhookDeliveryList List[WebhookDelivery]
+
+// WebhookResponse is the response of a synchronous webhook if the workflow did not complete with output.
+type WebhookResponse struct {
+	WorkflowExecutionID string        `json:"workflowExecutionID,omitempty"`
+	State               WorkflowState `json:"state,omitempty"`
+	Error               string        `json:"error,omitempty"`
+}
@@ -1,6 +1,10 @@
 package types
 
-import "strings"
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
 
 type Workflow struct {
 	Metadata
@@ -16,6 +20,10 @@ type WorkflowManifest struct {
 	AgentManifest `json:",inline"`
 	Steps         []Step `json:"steps"`
 	Output        string `json:"output"`
+	// OutputSchema is a JSON Schema the final output of the workflow must match.
+	OutputSchema json.RawMessage `json:"outputSchema,omitempty"`
+	// Timeout is the maximum duration of an execution of the workflow, such as "30m".
+	Timeout string `json:"timeout,omitempty"`
 }
 
 type EnvVar struct {
@@ -30,6 +38,9 @@ type Step struct {
 	Description string    `json:"description,omitempty"`
 	If          *If       `json:"if,omitempty"`
 	While       *While    `json:"while,omitempty"`
+	Parallel    *Parallel `json:"parallel,omitempty"`
+	ForEach     *ForEach  `json:"forEach,omitempty"`
+	Approval    *Approval `json:"approval,omitempty"`
 	Template    *Template `json:"template,omitempty"`
 	Tools       []string  `json:"tools,omitempty"`
 	Agents      []string  `json:"agents,omitempty"`
@@ -38,11 +49,20 @@ type Step struct {
 	Step        string   `json:"step,omitempty"`
 	Cache       *bool    `json:"cache,omitempty"`
 	Temperature *float32 `json:"temperature,omitempty"`
+	Retry       *Retry   `json:"retry,omitempty"`
+	OutputName  string   `json:"outputName,omitempty"`
+	// OutputSchema is a JSON Schema the output of the step must match. The step is re-prompted if it does not.
+	OutputSchema json.RawMessage `json:"outputSchema,omitempty"`
+	// Timeout is the maximum duration of the step including retries and nested steps, such as "5m".
+	Timeout string `json:"timeout,omitempty"`
 }
 
 func (s *Step) SetCondition(condition string) {
 	s.Step = ""
 	s.Template = nil
+	s.Parallel = nil
+	s.ForEach = nil
+	s.Approval = nil
 	if s.While != nil {
 		s.If = nil
 		s.While.Condition = condition
@@ -59,6 +79,9 @@ func (s *Step) SetArgs(args map[string]string) {
 	}
 	s.If = nil
 	s.While = nil
+	s.Parallel = nil
+	s.ForEach = nil
+	s.Approval = nil
 	s.Step = ""
 }
 
@@ -67,6 +90,9 @@ func (s *Step) SetPrompt(prompt string) {
 	s.Template = nil
 	s.While = nil
 	s.If = nil
+	s.Parallel = nil
+	s.ForEach = nil
+	s.Approval = nil
 }
 
 type Template struct {
@@ -84,11 +110,22 @@ func (s Step) Display() string {
 	}
 	if s.While != nil {
 		preamble.WriteString(" while ")
-		preamble.WriteString(oneLine(s.While.Condition))
+		preamble.WriteString(oneLine(firstNonEmpty(s.While.Expression, s.While.Condition)))
 	}
 	if s.If != nil {
 		preamble.WriteString(" if ")
-		preamble.WriteString(oneLine(s.If.Condition))
+		preamble.WriteString(oneLine(firstNonEmpty(s.If.Expression, s.If.Condition)))
+	}
+	if s.Parallel != nil {
+		preamble.WriteString(fmt.Sprintf(" parallel (%d branches)", len(s.Parallel.Branches)))
+	}
+	if s.ForEach != nil {
+		preamble.WriteString(" for each in ")
+		preamble.WriteString(s.ForEach.ItemsOrDefault())
+	}
+	if s.Approval != nil {
+		preamble.WriteString(" approval ")
+		preamble.WriteString(oneLine(s.Approval.Message))
 	}
 	if s.Step != "" {
 		preamble.WriteString(" ")
@@ -103,15 +140,79 @@ type SubFlow struct {
 }
 
 type If struct {
-	Condition string `json:"condition,omitempty"`
-	Steps     []Step `json:"steps,omitempty"`
-	Else      []Step `json:"else,omitempty"`
+	Condition  string `json:"condition,omitempty"`
+	Expression string `json:"expression,omitempty"`
+	Steps      []Step `json:"steps,omitempty"`
+	Else       []Step `json:"else,omitempty"`
 }
 
 type While struct {
-	Condition string `json:"condition,omitempty"`
-	MaxLoops  int    `json:"maxLoops,omitempty"`
-	Steps     []Step `json:"steps,omitempty"`
+	Condition  string `json:"condition,omitempty"`
+	Expression string `json:"expression,omitempty"`
+	MaxLoops   int    `json:"maxLoops,omitempty"`
+	Steps      []Step `json:"steps,omitempty"`
+}
+
+// Parallel runs the steps of each branch concurrently.
+type Parallel struct {
+	Branches []ParallelBranch `json:"branches,omitempty"`
+}
+
+type ParallelBranch struct {
+	Steps []Step `json:"steps,omitempty"`
+}
+
+type ForEach struct {
+	Items          string `json:"items,omitempty"`
+	MaxConcurrency int    `json:"maxConcurrency,omitempty"`
+	Steps          []Step `json:"steps,omitempty"`
+}
+
+// ItemsOrDefault returns the path to the JSON list to iterate over. The path starts with "input" for the
+// workflow input or "output" for the output of the previous step, followed by optional dotted field names.
+func (f ForEach) ItemsOrDefault() string {
+	if f.Items == "" {
+		return "output"
+	}
+	return f.Items
+}
+
+type Approval struct {
+	Message string `json:"message,omitempty"`
+	// Approvers are the email addresses that a link to approve or reject the step is sent to. Sending requires an
+	// outbound SMTP relay.
+	Approvers []string `json:"approvers,omitempty"`
+}
+
+type RetryErrorClass string
+
+const (
+	// RetryErrorClassRateLimit matches model provider rate limit (HTTP 429) errors.
+	RetryErrorClassRateLimit RetryErrorClass = "rateLimit"
+	// RetryErrorClassServerError matches model provider server (HTTP 5xx) errors.
+	RetryErrorClassServerError RetryErrorClass = "serverError"
+	// RetryErrorClassToolError matches errors from running a tool.
+	RetryErrorClassToolError RetryErrorClass = "toolError"
+)
+
+type Retry struct {
+	// MaxAttempts is the total number of times the step is run, including the first attempt.
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+	// Backoff is the delay before the first retry, such as "30s". The delay doubles for each following retry.
+	Backoff string `json:"backoff,omitempty"`
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff string `json:"maxBackoff,omitempty"`
+	// On lists the error classes that are retried. All errors are retried if empty.
+	On []RetryErrorClass `json:"on,omitempty"`
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
 }
 
 func oneLine(s string) string {
@@ -153,6 +254,18 @@ func findInSteps(parentID string, steps []Step, id string) (*Step, string) {
 				return found, parentID
 			}
 		}
+		if step.ForEach != nil {
+			if found, parentID := findInSteps(step.ID, step.ForEach.Steps, id); found != nil {
+				return found, parentID
+			}
+		}
+		if step.Parallel != nil {
+			for _, branch := range step.Parallel.Branches {
+				if found, parentID := findInSteps(step.ID, branch.Steps, id); found != nil {
+					return found, parentID
+				}
+			}
+		}
 	}
 	return nil, ""
 }
@@ -1,12 +1,48 @@
 package types
 
+import "encoding/json"
+
 type WorkflowExecution struct {
 	Metadata
-	Workflow  WorkflowManifest `json:"workflow,omitempty"`
-	StartTime Time             `json:"startTime"`
-	EndTime   *Time            `json:"endTime"`
-	Input     string           `json:"input"`
-	Error     string           `json:"error,omitempty"`
+	Workflow     WorkflowManifest `json:"workflow,omitempty"`
+	StartTime    Time             `json:"startTime"`
+	EndTime      *Time            `json:"endTime"`
+	Input        string           `json:"input"`
+	State        WorkflowState    `json:"state,omitempty"`
+	Output       string           `json:"output,omitempty"`
+	OutputObject json.RawMessage  `json:"outputObject,omitempty"`
+	Error        string           `json:"error,omitempty"`
 }
 
 type WorkflowExecutionList List[WorkflowExecution]
+
+type WorkflowExecutionApproval struct {
+	StepID  string `json:"stepID,omitempty"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type WorkflowExecutionResume struct {
+	// FromStep is the ID of the step to resume from. By default, the execution resumes from the steps that failed.
+	FromStep string `json:"fromStep,omitempty"`
+}
+
+type WorkflowExecutionDetail struct {
+	WorkflowExecution
+	TokenUsage TokenUsage              `json:"tokenUsage,omitempty"`
+	Steps      []WorkflowExecutionStep `json:"steps,omitempty"`
+}
+
+type WorkflowExecutionStep struct {
+	ID         string        `json:"id,omitempty"`
+	Name       string        `json:"name,omitempty"`
+	State      WorkflowState `json:"state,omitempty"`
+	StartTime  *Time         `json:"startTime,omitempty"`
+	EndTime    *Time         `json:"endTime,omitempty"`
+	RunIDs     []string      `json:"runIDs,omitempty"`
+	LastRunID  string        `json:"lastRunID,omitempty"`
+	Error      string        `json:"error,omitempty"`
+	RetryCount int           `json:"retryCount,omitempty"`
+	// TokenUsage is the tokens consumed by the runs of this step and all of its nested steps.
+	TokenUsage TokenUsage              `json:"tokenUsage,omitempty"`
+	Steps      []WorkflowExecutionStep `json:"steps,omitempty"`
+}
@@ -49,3 +49,44 @@ func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (c *Client) ListWebhookDeliveries(ctx context.Context, webhookID string) (result types.WebhookDeliveryList, _ error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/webhooks/%s/deliveries", webhookID), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return result, err
+}
+
+func (c *Client) ReplayWebhookDelivery(ctx context.Context, webhookID, deliveryID string) (*types.WebhookDelivery, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/webhooks/%s/deliveries/%s/replay", webhookID, deliveryID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.WebhookDelivery{})
+}
+
+func (c *Client) SuspendWebhook(ctx context.Context, id string) (*types.Webhook, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/webhooks/%s/actions/suspend", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.Webhook{})
+}
+
+func (c *Client) ResumeWebhook(ctx context.Context, id string) (*types.Webhook, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/webhooks/%s/actions/resume", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.Webhook{})
+}
@@ -127,3 +127,41 @@ func (c *Client) AuthenticateWorkflow(ctx context.Context, wfID string) (*types.
 		ThreadID: resp.Header.Get("X-Otto-Thread-Id"),
 	}, nil
 }
+
+func (c *Client) GetWorkflowExecution(ctx context.Context, id string) (*types.WorkflowExecutionDetail, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/workflow-executions/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.WorkflowExecutionDetail{})
+}
+
+func (c *Client) ApproveWorkflowExecution(ctx context.Context, id string, approval types.WorkflowExecutionApproval) error {
+	_, resp, err := c.postJSON(ctx, fmt.Sprintf("/workflow-executions/%s/approve", id), approval)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (c *Client) RejectWorkflowExecution(ctx context.Context, id string, approval types.WorkflowExecutionApproval) error {
+	_, resp, err := c.postJSON(ctx, fmt.Sprintf("/workflow-executions/%s/reject", id), approval)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (c *Client) ResumeWorkflowExecution(ctx context.Context, id string, resume types.WorkflowExecutionResume) (*types.WorkflowExecution, error) {
+	_, resp, err := c.postJSON(ctx, fmt.Sprintf("/workflow-executions/%s/resume", id), resume)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.WorkflowExecution{})
+}
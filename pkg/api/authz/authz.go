@@ -32,6 +32,8 @@ var staticRules = map[string][]string{
 		"/oauth2/",
 
 		"POST /api/webhooks/{namespace}/{id}",
+		"GET /api/workflow-executions/{namespace}/{id}/approval",
+		"POST /api/workflow-executions/{namespace}/{id}/approval",
 		"GET /api/token-request/{id}",
 		"POST /api/token-request",
 		"GET /api/token-request/{id}/{service}",
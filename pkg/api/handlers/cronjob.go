@@ -11,7 +11,6 @@ import (
 	"github.com/obot-platform/obot/pkg/controller/handlers/cronjob"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
-	"github.com/robfig/cron/v3"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -73,6 +72,9 @@ func (a *CronJobHandler) Update(req api.Context) error {
 		return err
 	}
 
+	// A cron job is only suspended or resumed through its actions, so that an update that leaves the field out does not
+	// resume it.
+	manifest.Suspended = cronJob.Spec.Suspended
 	cronJob.Spec.CronJobManifest = *manifest
 	if err = req.Update(&cronJob); err != nil {
 		return err
@@ -81,6 +83,30 @@ func (a *CronJobHandler) Update(req api.Context) error {
 	return req.Write(convertCronJob(cronJob))
 }
 
+func (a *CronJobHandler) Suspend(req api.Context) error {
+	return a.setSuspended(req, true)
+}
+
+func (a *CronJobHandler) Resume(req api.Context) error {
+	return a.setSuspended(req, false)
+}
+
+func (a *CronJobHandler) setSuspended(req api.Context, suspended bool) error {
+	var cronJob v1.CronJob
+	if err := req.Get(&cronJob, req.PathValue("id")); err != nil {
+		return err
+	}
+
+	if cronJob.Spec.Suspended != suspended {
+		cronJob.Spec.Suspended = suspended
+		if err := req.Update(&cronJob); err != nil {
+			return err
+		}
+	}
+
+	return req.Write(convertCronJob(cronJob))
+}
+
 func (a *CronJobHandler) Delete(req api.Context) error {
 	var (
 		id = req.PathValue("id")
@@ -134,7 +160,7 @@ func (a *CronJobHandler) Execute(req api.Context) error {
 
 func convertCronJob(cronJob v1.CronJob) types.CronJob {
 	var nextRunAt *time.Time
-	if sched, err := cron.ParseStandard(cronjob.GetSchedule(cronJob)); err == nil {
+	if sched, err := cronjob.ParseSchedule(cronjob.GetSchedule(cronJob), cronjob.GetTimezone(cronJob)); err == nil && !cronJob.Spec.Suspended {
 		nextRunAt = new(time.Time)
 		*nextRunAt = sched.Next(time.Now())
 	}
@@ -153,10 +179,22 @@ func parseAndValidateCronManifest(req api.Context) (*types.CronJobManifest, erro
 	if err := req.Read(&manifest); err != nil {
 		return nil, err
 	}
-	if _, err := cron.ParseStandard(manifest.Schedule); err != nil {
+	if _, err := cronjob.ParseSchedule(manifest.Schedule, manifest.Timezone); err != nil {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid schedule %s: %v", manifest.Schedule, err))
 	}
 
+	switch manifest.ConcurrencyPolicy {
+	case "", types.CronJobConcurrencyPolicyAllow, types.CronJobConcurrencyPolicyForbid, types.CronJobConcurrencyPolicyReplace:
+	default:
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid concurrency policy %q", manifest.ConcurrencyPolicy))
+	}
+
+	if manifest.StartingDeadline != "" {
+		if deadline, err := time.ParseDuration(manifest.StartingDeadline); err != nil || deadline <= 0 {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid starting deadline %q", manifest.StartingDeadline))
+		}
+	}
+
 	var workflow v1.Workflow
 	if err := alias.Get(req.Context(), req.Storage, &workflow, req.Namespace(), manifest.Workflow); err != nil {
 		return nil, err
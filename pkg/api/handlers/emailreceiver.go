@@ -1,11 +1,14 @@
 package handlers
 
 import (
+	"fmt"
+
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/alias"
 	"github.com/obot-platform/obot/pkg/api"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -34,6 +37,13 @@ func (e *EmailReceiverHandler) Update(req api.Context) error {
 		return err
 	}
 
+	if err := validateEmailReceiverManifest(manifest); err != nil {
+		return err
+	}
+
+	// An email receiver is only suspended or resumed through its actions, so that an update that leaves the field out
+	// does not resume it.
+	manifest.Suspended = er.Spec.Suspended
 	er.Spec.EmailReceiverManifest = manifest
 	if err := req.Update(&er); err != nil {
 		return err
@@ -42,6 +52,30 @@ func (e *EmailReceiverHandler) Update(req api.Context) error {
 	return req.Write(convertEmailReceiver(er, e.hostname))
 }
 
+func (e *EmailReceiverHandler) Suspend(req api.Context) error {
+	return e.setSuspended(req, true)
+}
+
+func (e *EmailReceiverHandler) Resume(req api.Context) error {
+	return e.setSuspended(req, false)
+}
+
+func (e *EmailReceiverHandler) setSuspended(req api.Context, suspended bool) error {
+	var er v1.EmailReceiver
+	if err := alias.Get(req.Context(), req.Storage, &er, req.Namespace(), req.PathValue("id")); err != nil {
+		return err
+	}
+
+	if er.Spec.Suspended != suspended {
+		er.Spec.Suspended = suspended
+		if err := req.Update(&er); err != nil {
+			return err
+		}
+	}
+
+	return req.Write(convertEmailReceiver(er, e.hostname))
+}
+
 func (e *EmailReceiverHandler) Delete(req api.Context) error {
 	var (
 		id = req.PathValue("id")
@@ -61,6 +95,10 @@ func (e *EmailReceiverHandler) Create(req api.Context) error {
 		return err
 	}
 
+	if err := validateEmailReceiverManifest(manifest); err != nil {
+		return err
+	}
+
 	er := &v1.EmailReceiver{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: system.EmailReceiverPrefix,
@@ -78,6 +116,21 @@ func (e *EmailReceiverHandler) Create(req api.Context) error {
 	return req.WriteCreated(convertEmailReceiver(*er, e.hostname))
 }
 
+func validateEmailReceiverManifest(manifest types.EmailReceiverManifest) error {
+	switch manifest.AuthenticationPolicy {
+	case "", types.EmailAuthenticationPolicyFlag, types.EmailAuthenticationPolicyReject:
+	default:
+		return apierrors.NewBadRequest(fmt.Sprintf("unknown authentication policy %q", manifest.AuthenticationPolicy))
+	}
+	if manifest.MaxAttachmentSize < 0 {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid max attachment size %d", manifest.MaxAttachmentSize))
+	}
+	if manifest.MaxTotalAttachmentSize < 0 {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid max total attachment size %d", manifest.MaxTotalAttachmentSize))
+	}
+	return nil
+}
+
 func convertEmailReceiver(emailReceiver v1.EmailReceiver, hostname string) *types.EmailReceiver {
 	manifest := emailReceiver.Spec.EmailReceiverManifest
 
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type NotificationTargetHandler struct{}
+
+func NewNotificationTargetHandler() *NotificationTargetHandler {
+	return &NotificationTargetHandler{}
+}
+
+func (a *NotificationTargetHandler) List(req api.Context) error {
+	var targets v1.NotificationTargetList
+	if err := req.List(&targets); err != nil {
+		return err
+	}
+
+	items := make([]types.NotificationTarget, 0, len(targets.Items))
+	for _, target := range targets.Items {
+		items = append(items, convertNotificationTarget(target))
+	}
+	return req.Write(types.NotificationTargetList{Items: items})
+}
+
+func (a *NotificationTargetHandler) ByID(req api.Context) error {
+	var target v1.NotificationTarget
+	if err := req.Get(&target, req.PathValue("id")); err != nil {
+		return err
+	}
+
+	return req.Write(convertNotificationTarget(target))
+}
+
+func (a *NotificationTargetHandler) Create(req api.Context) error {
+	var manifest types.NotificationTargetManifest
+	if err := req.Read(&manifest); err != nil {
+		return err
+	}
+
+	if err := validateNotificationTargetManifest(manifest); err != nil {
+		return err
+	}
+
+	target := v1.NotificationTarget{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.NotificationTargetPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.NotificationTargetSpec{
+			NotificationTargetManifest: manifest,
+		},
+	}
+
+	if err := req.Create(&target); err != nil {
+		return err
+	}
+
+	return req.WriteCreated(convertNotificationTarget(target))
+}
+
+func (a *NotificationTargetHandler) Update(req api.Context) error {
+	var target v1.NotificationTarget
+	if err := req.Get(&target, req.PathValue("id")); err != nil {
+		return err
+	}
+
+	var manifest types.NotificationTargetManifest
+	if err := req.Read(&manifest); err != nil {
+		return err
+	}
+
+	// The secret is not returned by the API, so keep the current one if a new one isn't given.
+	if manifest.Secret == "" {
+		manifest.Secret = target.Spec.Secret
+	}
+
+	if err := validateNotificationTargetManifest(manifest); err != nil {
+		return err
+	}
+
+	target.Spec.NotificationTargetManifest = manifest
+	if err := req.Update(&target); err != nil {
+		return err
+	}
+
+	return req.Write(convertNotificationTarget(target))
+}
+
+func (a *NotificationTargetHandler) Delete(req api.Context) error {
+	return req.Delete(&v1.NotificationTarget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.PathValue("id"),
+			Namespace: req.Namespace(),
+		},
+	})
+}
+
+func convertNotificationTarget(target v1.NotificationTarget) types.NotificationTarget {
+	result := types.NotificationTarget{
+		Metadata:                   MetadataFrom(&target),
+		NotificationTargetManifest: target.Spec.NotificationTargetManifest,
+		LastDeliveryAt:             v1.NewTime(target.Status.LastDeliveryAt),
+		LastDeliveryError:          target.Status.LastDeliveryError,
+	}
+
+	if target.Spec.Secret != "" {
+		result.Secret = fmt.Sprintf("%x", sha256.Sum256([]byte(target.Spec.Secret)))
+	}
+
+	return result
+}
+
+func validateNotificationTargetManifest(manifest types.NotificationTargetManifest) error {
+	u, err := url.Parse(manifest.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid notification target URL %q", manifest.URL))
+	}
+
+	for _, event := range manifest.Events {
+		switch event {
+		case types.NotificationEventComplete, types.NotificationEventError, types.NotificationEventBlocked, types.NotificationEventNeedsAuth:
+		default:
+			return apierrors.NewBadRequest(fmt.Sprintf("unknown notification event %q", event))
+		}
+	}
+
+	return nil
+}
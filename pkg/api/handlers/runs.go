@@ -42,6 +42,7 @@ func convertRun(run v1.Run) types.Run {
 		State:          state,
 		Output:         run.Status.Output,
 		Error:          run.Status.Error,
+		TokenUsage:     run.Status.TokenUsage,
 	}
 	if run.Status.SubCall != nil {
 		result.SubCallWorkflowID = run.Status.SubCall.Workflow
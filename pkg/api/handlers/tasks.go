@@ -362,6 +362,11 @@ func validate(task types.TaskManifest) error {
 	if count > 1 {
 		return types.NewErrBadRequest("only one trigger is allowed, schedule, webhook, onDemand, or email")
 	}
+	if task.Schedule != nil && task.Schedule.Timezone != "" {
+		if _, err := time.LoadLocation(task.Schedule.Timezone); err != nil {
+			return types.NewErrBadRequest("invalid schedule timezone %q: %v", task.Schedule.Timezone, err)
+		}
+	}
 	return nil
 }
 
@@ -109,12 +109,15 @@ func convertWorkflowExecution(we v1.WorkflowExecution) types.WorkflowExecution {
 		endTime = types.NewTime(we.Status.EndTime.Time)
 	}
 	return types.WorkflowExecution{
-		Metadata:  MetadataFrom(&we),
-		Workflow:  w,
-		Input:     we.Spec.Input,
-		Error:     we.Status.Error,
-		StartTime: *types.NewTime(we.CreationTimestamp.Time),
-		EndTime:   endTime,
+		Metadata:     MetadataFrom(&we),
+		Workflow:     w,
+		Input:        we.Spec.Input,
+		State:        we.Status.State,
+		Output:       we.Status.Output,
+		OutputObject: we.Status.OutputObject,
+		Error:        we.Status.Error,
+		StartTime:    *types.NewTime(we.CreationTimestamp.Time),
+		EndTime:      endTime,
 	}
 }
 
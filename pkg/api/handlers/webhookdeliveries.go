@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/gz"
+	"github.com/obot-platform/obot/pkg/hash"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+// maxWebhookDeliveryBodySize is the largest request body kept with a delivery so that it can be replayed.
+const maxWebhookDeliveryBodySize = 256 * 1024
+
+func newWebhookDelivery(webhook *v1.Webhook, header http.Header, body []byte) (*v1.WebhookDelivery, error) {
+	delivery := &v1.WebhookDelivery{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.WebhookDeliveryPrefix,
+			Namespace:    webhook.Namespace,
+		},
+		Spec: v1.WebhookDeliverySpec{
+			WebhookName: webhook.Name,
+			Headers:     map[string]string{},
+			BodySize:    len(body),
+			BodySHA256:  fmt.Sprintf("%x", sha256.Sum256(body)),
+		},
+	}
+
+	if webhook.Spec.IdempotencyHeader != "" {
+		delivery.Spec.IdempotencyKey = header.Get(webhook.Spec.IdempotencyHeader)
+	} else if webhook.Spec.IdempotencyField != "" {
+		delivery.Spec.IdempotencyKey = payloadField(body, webhook.Spec.IdempotencyField)
+	}
+
+	allHeaders := slices.Contains(webhook.Spec.Headers, "*")
+	for k := range header {
+		if k == WebhookTokenHTTPHeader || (!allHeaders && !slices.Contains(webhook.Spec.Headers, k)) {
+			continue
+		}
+		delivery.Spec.Headers[k] = header.Get(k)
+	}
+
+	if len(body) <= maxWebhookDeliveryBodySize {
+		var err error
+		if delivery.Spec.Body, err = gz.Compress(body); err != nil {
+			return nil, err
+		}
+	}
+
+	return delivery, nil
+}
+
+// payloadField returns the value at the dotted path in a JSON payload, or an empty string if there isn't one.
+func payloadField(body []byte, path string) string {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return ""
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return ""
+		}
+		value = obj[key]
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+	return ""
+}
+
+// claimDelivery acknowledges a delivery with the same idempotency key as a delivery that started the workflow, or is
+// still processing, in the webhook's idempotency window. It returns a zero status if the delivery is not a duplicate. Otherwise, the
+// delivery is created under a name derived from the webhook and the key, so that of concurrent deliveries with the same
+// key only one can be created and trigger the workflow.
+func claimDelivery(req api.Context, webhook *v1.Webhook, delivery *v1.WebhookDelivery) (int, error) {
+	key := delivery.Spec.IdempotencyKey
+	if key == "" {
+		return 0, nil
+	}
+
+	window, err := webhook.GetIdempotencyWindow()
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	claimName := system.WebhookDeliveryPrefix + hash.String(webhook.Name + "\x00" + key)[:16]
+	for range 3 {
+		delivery.Name = claimName
+		if err := req.Create(delivery); err == nil {
+			return 0, nil
+		} else if !apierrors.IsAlreadyExists(err) {
+			return http.StatusInternalServerError, err
+		}
+		delivery.Name = ""
+
+		var previous v1.WebhookDelivery
+		if err := req.Get(&previous, claimName); apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		if previous.Spec.WebhookName != webhook.Name || previous.Spec.IdempotencyKey != key {
+			// Another key hashed to the same name, so this delivery can't be checked.
+			return 0, nil
+		}
+
+		if time.Since(previous.CreationTimestamp.Time) < window &&
+			(previous.Spec.Result == "" || previous.Spec.WorkflowExecutionName != "") {
+			delivery.Spec.Result = types.WebhookDeliveryResultDuplicate
+			delivery.Spec.WorkflowExecutionName = previous.Spec.WorkflowExecutionName
+			return http.StatusNoContent, nil
+		}
+
+		// The previous delivery is outside the window or did not start the workflow, so this one takes over its name.
+		if err := req.Storage.Delete(req.Context(), &previous, kclient.Preconditions{
+			UID:             &previous.UID,
+			ResourceVersion: &previous.ResourceVersion,
+		}); kclient.IgnoreNotFound(err) != nil && !apierrors.IsConflict(err) {
+			return http.StatusInternalServerError, err
+		}
+	}
+
+	return http.StatusConflict, types.NewErrHttp(http.StatusConflict, fmt.Sprintf("delivery %s is being processed", key))
+}
+
+// recordWebhookDelivery saves a delivery with its outcome. Failing to save it does not fail the webhook request. A
+// claimed delivery that did not start the workflow releases its name so that a later delivery with the same key is not
+// acknowledged as a duplicate of it.
+func recordWebhookDelivery(req api.Context, delivery *v1.WebhookDelivery, status int, err error) {
+	delivery.Spec.StatusCode = status
+	if err != nil {
+		delivery.Spec.Result = types.WebhookDeliveryResultFailed
+		delivery.Spec.Error = err.Error()
+	}
+
+	if delivery.ResourceVersion != "" {
+		if delivery.Spec.WorkflowExecutionName != "" {
+			if err := req.Update(delivery); err != nil {
+				log.Errorf("failed to record delivery for webhook %s: %v", delivery.Spec.WebhookName, err)
+			}
+			return
+		}
+		if err := req.Delete(delivery); err != nil {
+			log.Errorf("failed to release delivery for webhook %s: %v", delivery.Spec.WebhookName, err)
+		}
+		delivery.ObjectMeta = metav1.ObjectMeta{
+			GenerateName: system.WebhookDeliveryPrefix,
+			Namespace:    delivery.Namespace,
+		}
+	}
+
+	if err := req.Create(delivery); err != nil {
+		log.Errorf("failed to record delivery for webhook %s: %v", delivery.Spec.WebhookName, err)
+	}
+}
+
+func convertWebhookDelivery(delivery v1.WebhookDelivery) types.WebhookDelivery {
+	return types.WebhookDelivery{
+		Metadata:            MetadataFrom(&delivery),
+		WebhookID:           delivery.Spec.WebhookName,
+		Headers:             delivery.Spec.Headers,
+		BodySize:            delivery.Spec.BodySize,
+		BodySHA256:          delivery.Spec.BodySHA256,
+		Result:              delivery.Spec.Result,
+		StatusCode:          delivery.Spec.StatusCode,
+		Error:               delivery.Spec.Error,
+		WorkflowExecutionID: delivery.Spec.WorkflowExecutionName,
+		IdempotencyKey:      delivery.Spec.IdempotencyKey,
+		ReplayOf:            delivery.Spec.ReplayOf,
+		Replayable:          len(delivery.Spec.Body) > 0,
+	}
+}
+
+func (a *WebhookHandler) Deliveries(req api.Context) error {
+	var deliveries v1.WebhookDeliveryList
+	if err := req.List(&deliveries, kclient.MatchingFields{
+		"spec.webhookName": req.PathValue("id"),
+	}); err != nil {
+		return err
+	}
+
+	slices.SortFunc(deliveries.Items, func(a, b v1.WebhookDelivery) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+
+	resp := types.WebhookDeliveryList{
+		Items: make([]types.WebhookDelivery, 0, len(deliveries.Items)),
+	}
+	for _, delivery := range deliveries.Items {
+		resp.Items = append(resp.Items, convertWebhookDelivery(delivery))
+	}
+
+	return req.Write(resp)
+}
+
+// ReplayDelivery runs a recorded delivery through the webhook again. The signature and token are not checked again
+// because the replay is requested by an authenticated user, but the filter and mapping of the current webhook apply.
+func (a *WebhookHandler) ReplayDelivery(req api.Context) error {
+	var webhook v1.Webhook
+	if err := req.Get(&webhook, req.PathValue("id")); err != nil {
+		return err
+	}
+
+	var original v1.WebhookDelivery
+	if err := req.Get(&original, req.PathValue("delivery_id")); err != nil {
+		return err
+	}
+
+	if webhook.Spec.Suspended {
+		return types.NewErrHttp(http.StatusConflict, fmt.Sprintf("webhook %s is suspended", webhook.Name))
+	}
+
+	if original.Spec.WebhookName != webhook.Name {
+		return types.NewErrNotFound("delivery %s not found for webhook %s", original.Name, webhook.Name)
+	}
+
+	if len(original.Spec.Body) == 0 {
+		return types.NewErrBadRequest("delivery %s can not be replayed, its body was too large to keep", original.Name)
+	}
+
+	var body []byte
+	if err := gz.Decompress(&body, original.Spec.Body); err != nil {
+		return err
+	}
+
+	header := http.Header{}
+	for k, v := range original.Spec.Headers {
+		header.Set(k, v)
+	}
+
+	delivery, err := newWebhookDelivery(&webhook, header, body)
+	if err != nil {
+		return err
+	}
+	delivery.Spec.ReplayOf = original.Name
+	delivery.Spec.IdempotencyKey = original.Spec.IdempotencyKey
+
+	status, err := triggerWebhook(req, &webhook, delivery, header, body)
+	recordWebhookDelivery(req, delivery, status, err)
+
+	return req.WriteCreated(convertWebhookDelivery(*delivery))
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"sync"
+	"time"
+
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultRateLimitPeriod = time.Minute
+	// rejectedDeliveriesPerMinute is how many rejected deliveries of a webhook are recorded per minute.
+	rejectedDeliveriesPerMinute = 10
+)
+
+// webhookRateLimiters keeps a token bucket for each webhook with a rate limit, and one for the rejected deliveries of
+// each webhook that are recorded. The limits are kept in memory, so each API server enforces them separately.
+type webhookRateLimiters struct {
+	lock     sync.Mutex
+	limiters map[string]*rate.Limiter
+	rejected map[string]*rate.Limiter
+}
+
+func newWebhookRateLimiters() *webhookRateLimiters {
+	return &webhookRateLimiters{
+		limiters: map[string]*rate.Limiter{},
+		rejected: map[string]*rate.Limiter{},
+	}
+}
+
+// allowRejected returns true if another rejected delivery of the webhook can be recorded now. Rejected deliveries
+// don't count against the webhook's own rate limit, so that forged requests can't use up the limit of the real sender.
+func (w *webhookRateLimiters) allowRejected(webhook *v1.Webhook, now time.Time) bool {
+	w.lock.Lock()
+	key := webhook.Namespace + "/" + webhook.Name
+	limiter := w.rejected[key]
+	if limiter == nil {
+		limiter = rate.NewLimiter(rate.Every(time.Minute/rejectedDeliveriesPerMinute), rejectedDeliveriesPerMinute)
+		w.rejected[key] = limiter
+	}
+	w.lock.Unlock()
+
+	return limiter.AllowN(now, 1)
+}
+
+// delay returns how long until the webhook accepts another delivery, or zero if the delivery is allowed now.
+func (w *webhookRateLimiters) delay(webhook *v1.Webhook, now time.Time) time.Duration {
+	limit := webhook.Spec.RateLimit
+	if limit == nil || limit.Requests <= 0 {
+		return 0
+	}
+
+	period := defaultRateLimitPeriod
+	if limit.Period != "" {
+		if p, err := time.ParseDuration(limit.Period); err == nil && p > 0 {
+			period = p
+		}
+	}
+	every := rate.Every(period / time.Duration(limit.Requests))
+
+	w.lock.Lock()
+	key := webhook.Namespace + "/" + webhook.Name
+	limiter := w.limiters[key]
+	if limiter == nil {
+		limiter = rate.NewLimiter(every, limit.Requests)
+		w.limiters[key] = limiter
+	} else if limiter.Limit() != every || limiter.Burst() != limit.Requests {
+		limiter.SetLimitAt(now, every)
+		limiter.SetBurstAt(now, limit.Requests)
+	}
+	w.lock.Unlock()
+
+	reservation := limiter.ReserveN(now, 1)
+	if d := reservation.DelayFrom(now); d > 0 {
+		reservation.CancelAt(now)
+		return d
+	}
+	return 0
+}
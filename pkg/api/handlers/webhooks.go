@@ -1,21 +1,24 @@
 package handlers
 
 import (
-	"crypto/hmac"
 	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 	"net/textproto"
 	"slices"
-	"strings"
+	"strconv"
+	"time"
 
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/alias"
 	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/expression"
+	"github.com/obot-platform/obot/pkg/gz"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/wait"
 	"golang.org/x/crypto/bcrypt"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,12 +27,19 @@ import (
 const (
 	WebhookTokenHTTPHeader = "X-Otto8-Webhook-Token"
 	WebhookTokenQueryParam = "token"
+
+	defaultSynchronousTimeout = 30 * time.Second
+	maxSynchronousTimeout     = 5 * time.Minute
 )
 
-type WebhookHandler struct{}
+type WebhookHandler struct {
+	limiters *webhookRateLimiters
+}
 
 func NewWebhookHandler() *WebhookHandler {
-	return new(WebhookHandler)
+	return &WebhookHandler{
+		limiters: newWebhookRateLimiters(),
+	}
 }
 
 type webhookRequest struct {
@@ -52,7 +62,7 @@ func (a *WebhookHandler) Update(req api.Context) error {
 		return err
 	}
 
-	if webhookReq.WebhookManifest.ValidationHeader != "" && webhookReq.WebhookManifest.Secret == "" {
+	if webhookReq.WebhookManifest.SignatureHeader() != "" && webhookReq.WebhookManifest.Secret == "" {
 		webhookReq.WebhookManifest.Secret = wh.Spec.Secret
 	}
 
@@ -69,6 +79,9 @@ func (a *WebhookHandler) Update(req api.Context) error {
 		webhookReq.Token = ""
 	}
 
+	// A webhook is only suspended or resumed through its actions, so that an update that leaves the field out does not
+	// resume it.
+	webhookReq.WebhookManifest.Suspended = wh.Spec.Suspended
 	wh.Spec.WebhookManifest = webhookReq.WebhookManifest
 	for i, h := range wh.Spec.Headers {
 		wh.Spec.Headers[i] = textproto.CanonicalMIMEHeaderKey(h)
@@ -81,6 +94,36 @@ func (a *WebhookHandler) Update(req api.Context) error {
 	return req.Write(convertWebhook(wh, req.APIBaseURL))
 }
 
+func (a *WebhookHandler) Suspend(req api.Context) error {
+	return a.setSuspended(req, true)
+}
+
+func (a *WebhookHandler) Resume(req api.Context) error {
+	return a.setSuspended(req, false)
+}
+
+func (a *WebhookHandler) setSuspended(req api.Context, suspended bool) error {
+	// There is a chance that an unauthorized user could sneak through our authorization because of the pattern matching we are using.
+	// Check that the user is an admin here.
+	if !req.UserIsAdmin() {
+		return types.NewErrHttp(http.StatusForbidden, "unauthorized")
+	}
+
+	var wh v1.Webhook
+	if err := req.Get(&wh, req.PathValue("id")); err != nil {
+		return err
+	}
+
+	if wh.Spec.Suspended != suspended {
+		wh.Spec.Suspended = suspended
+		if err := req.Update(&wh); err != nil {
+			return err
+		}
+	}
+
+	return req.Write(convertWebhook(wh, req.APIBaseURL))
+}
+
 func (a *WebhookHandler) Delete(req api.Context) error {
 	return req.Delete(&v1.Webhook{
 		ObjectMeta: metav1.ObjectMeta{
@@ -215,10 +258,151 @@ func (a *WebhookHandler) Execute(req api.Context) error {
 		return fmt.Errorf("failed to read request body: %w", err)
 	}
 
-	if webhook.Spec.ValidationHeader != "" {
-		if err = validateSecretHeader(webhook.Spec.Secret, body, req.Request.Header.Values(webhook.Spec.ValidationHeader)); err != nil {
-			req.WriteHeader(http.StatusForbidden)
-			return nil
+	delivery, err := newWebhookDelivery(&webhook, req.Request.Header, body)
+	if err != nil {
+		return err
+	}
+
+	status, err := validateWebhookRequest(req, &webhook, body, delivery)
+	if err == nil && status == 0 {
+		// Only valid deliveries count against the rate limit, so that forged requests can't use up the limit of the
+		// real sender. Deliveries over the limit are not recorded so that a noisy sender can't create unbounded objects.
+		if delay := a.limiters.delay(&webhook, time.Now()); delay > 0 {
+			req.ResponseWriter.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			return types.NewErrHttp(http.StatusTooManyRequests, "webhook rate limit exceeded")
+		}
+		status, err = claimDelivery(req, &webhook, delivery)
+	}
+	if err == nil && status == 0 {
+		status, err = triggerWebhook(req, &webhook, delivery, req.Request.Header, body)
+	}
+
+	if delivery.Spec.Result.IsRejected() {
+		// Anyone who knows the URL can send a rejected delivery, so only a few are recorded and without the body and
+		// headers, so that they can't fill up storage.
+		if a.limiters.allowRejected(&webhook, time.Now()) {
+			delivery.Spec.Headers = nil
+			delivery.Spec.Body = nil
+			delivery.Spec.IdempotencyKey = ""
+			recordWebhookDelivery(req, delivery, status, err)
+		}
+		req.WriteHeader(status)
+		return nil
+	}
+
+	if err != nil || !webhook.Spec.Synchronous || delivery.Spec.WorkflowExecutionName == "" {
+		recordWebhookDelivery(req, delivery, status, err)
+		if err != nil {
+			return err
+		}
+		req.WriteHeader(status)
+		return nil
+	}
+
+	wfe, err := waitForWebhookWorkflow(req, &webhook, delivery.Spec.WorkflowExecutionName)
+	if err != nil {
+		recordWebhookDelivery(req, delivery, http.StatusInternalServerError, err)
+		return err
+	}
+
+	return writeWebhookResult(req, delivery, wfe)
+}
+
+// waitForWebhookWorkflow waits for the workflow execution started by a synchronous webhook to finish, or to need
+// approval, until the webhook's synchronous timeout.
+func waitForWebhookWorkflow(req api.Context, webhook *v1.Webhook, workflowExecutionName string) (*v1.WorkflowExecution, error) {
+	timeout := defaultSynchronousTimeout
+	if webhook.Spec.SynchronousTimeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(webhook.Spec.SynchronousTimeout); err != nil {
+			return nil, err
+		}
+	}
+
+	wfe, err := wait.For(req.Context(), req.Storage, &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workflowExecutionName,
+			Namespace: req.Namespace(),
+		},
+	}, func(wfe *v1.WorkflowExecution) (bool, error) {
+		return wfe.Status.State.IsTerminal() || wfe.Status.State.IsBlocked(), nil
+	}, wait.Option{
+		Timeout: timeout,
+	})
+	if err != nil {
+		// The execution did not finish in time, report it as it is now.
+		wfe = &v1.WorkflowExecution{}
+		if err := req.Get(wfe, workflowExecutionName); err != nil {
+			return nil, err
+		}
+	}
+
+	return wfe, nil
+}
+
+// writeWebhookResult responds to a synchronous webhook. A completed workflow responds with its output, as JSON if the
+// workflow has an output schema. Otherwise, the state of the execution is returned.
+func writeWebhookResult(req api.Context, delivery *v1.WebhookDelivery, wfe *v1.WorkflowExecution) error {
+	var (
+		status      = http.StatusAccepted
+		contentType = "application/json"
+		body        []byte
+		err         error
+	)
+
+	switch {
+	case wfe.Status.State == types.WorkflowStateComplete && len(wfe.Status.OutputObject) > 0:
+		status, body = http.StatusOK, wfe.Status.OutputObject
+	case wfe.Status.State == types.WorkflowStateComplete:
+		var output string
+		output, err = workflowExecutionOutput(req, wfe)
+		status, contentType, body = http.StatusOK, "text/plain", []byte(output)
+	default:
+		if wfe.Status.State == types.WorkflowStateError {
+			status = http.StatusInternalServerError
+		}
+		body, err = json.Marshal(types.WebhookResponse{
+			WorkflowExecutionID: wfe.Name,
+			State:               wfe.Status.State,
+			Error:               wfe.Status.Error,
+		})
+	}
+	if err != nil {
+		recordWebhookDelivery(req, delivery, http.StatusInternalServerError, err)
+		return err
+	}
+
+	recordWebhookDelivery(req, delivery, status, nil)
+	req.ResponseWriter.Header().Set("Content-Type", contentType)
+	req.WriteHeader(status)
+	_, err = req.ResponseWriter.Write(body)
+	return err
+}
+
+func workflowExecutionOutput(req api.Context, wfe *v1.WorkflowExecution) (string, error) {
+	if wfe.Status.LastRunName == "" {
+		return wfe.Status.Output, nil
+	}
+
+	var (
+		runState v1.RunState
+		output   string
+	)
+	if err := req.Get(&runState, wfe.Status.LastRunName); apierrors.IsNotFound(err) {
+		return wfe.Status.Output, nil
+	} else if err != nil {
+		return "", err
+	}
+	return output, gz.Decompress(&output, runState.Spec.Output)
+}
+
+// validateWebhookRequest checks the signature and token of a request, and that the webhook is not suspended. If the
+// request is not valid, the status to respond with is returned.
+func validateWebhookRequest(req api.Context, webhook *v1.Webhook, body []byte, delivery *v1.WebhookDelivery) (int, error) {
+	if webhook.Spec.SignatureHeader() != "" {
+		if err := validateSignature(webhook.Spec.WebhookManifest, req.Request.Header, body, time.Now()); err != nil {
+			delivery.Spec.Result = types.WebhookDeliveryResultInvalidSignature
+			return http.StatusForbidden, nil
 		}
 	}
 
@@ -229,41 +413,46 @@ func (a *WebhookHandler) Execute(req api.Context) error {
 		}
 
 		if err := bcrypt.CompareHashAndPassword(webhook.Spec.TokenHash, []byte(password)); err != nil {
-			req.WriteHeader(http.StatusForbidden)
-			return nil
+			delivery.Spec.Result = types.WebhookDeliveryResultInvalidToken
+			return http.StatusForbidden, nil
 		}
 	}
 
-	var input struct {
-		Type    string            `json:"type"`
-		Payload string            `json:"payload"`
-		Headers map[string]string `json:"headers"`
+	// Only a caller that passed the checks above learns that the webhook is suspended.
+	if webhook.Spec.Suspended {
+		delivery.Spec.Result = types.WebhookDeliveryResultSuspended
+		return http.StatusServiceUnavailable, nil
 	}
 
-	input.Type = "webhook"
-	input.Payload = string(body)
-	input.Headers = make(map[string]string)
+	return 0, nil
+}
 
-	allHeaders := slices.Contains(webhook.Spec.Headers, "*")
-	for k := range req.Request.Header {
-		if !allHeaders && !slices.Contains(webhook.Spec.Headers, k) {
-			continue
+// triggerWebhook starts the webhook's workflow for a delivery that passed validation and returns the status to respond
+// with.
+func triggerWebhook(req api.Context, webhook *v1.Webhook, delivery *v1.WebhookDelivery, header http.Header, body []byte) (int, error) {
+	vars := webhookExpressionVars(header, body)
+	if webhook.Spec.Filter != "" {
+		matches, err := expression.Evaluate(webhook.Spec.Filter, vars)
+		if err != nil {
+			return http.StatusBadRequest, types.NewErrBadRequest("failed to evaluate webhook filter: %v", err)
+		}
+		if !matches {
+			delivery.Spec.Result = types.WebhookDeliveryResultFiltered
+			return http.StatusAccepted, nil
 		}
-
-		input.Headers[k] = req.Request.Header.Get(k)
 	}
 
-	inputText, err := json.Marshal(input)
+	inputText, err := webhookInput(webhook, header, body, vars)
 	if err != nil {
-		return fmt.Errorf("failed to marshal input: %w", err)
+		return http.StatusBadRequest, err
 	}
 
 	var workflow v1.Workflow
 	if err := alias.Get(req.Context(), req.Storage, &workflow, req.Namespace(), webhook.Spec.WebhookManifest.Workflow); err != nil {
-		return err
+		return http.StatusInternalServerError, err
 	}
 
-	if err = req.Create(&v1.WorkflowExecution{
+	wfe := &v1.WorkflowExecution{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: system.WorkflowExecutionPrefix,
 			Namespace:    req.Namespace(),
@@ -274,34 +463,65 @@ func (a *WebhookHandler) Execute(req api.Context) error {
 			ThreadName:   webhook.Spec.ThreadName,
 			Input:        string(inputText),
 		},
-	}); err != nil && !apierrors.IsAlreadyExists(err) {
-		return err
+	}
+	if err = req.Create(wfe); err != nil && !apierrors.IsAlreadyExists(err) {
+		return http.StatusInternalServerError, err
 	}
 
-	req.WriteHeader(http.StatusNoContent)
-	return nil
+	delivery.Spec.Result = types.WebhookDeliveryResultAccepted
+	delivery.Spec.WorkflowExecutionName = wfe.Name
+	return http.StatusNoContent, nil
+}
+
+// webhookExpressionVars returns the variables available to webhook filter and mapping expressions.
+func webhookExpressionVars(header http.Header, body []byte) map[string]any {
+	headers := make(map[string]any, len(header))
+	for k := range header {
+		headers[k] = header.Get(k)
+	}
+	return map[string]any{
+		"payload": expression.Value(string(body)),
+		"headers": headers,
+	}
 }
 
-func validateSecretHeader(secret string, body []byte, values []string) error {
-	h := hmac.New(sha256.New, []byte(secret))
-	for _, v := range values {
-		for _, val := range strings.Split(v, ",") {
-			_, val, _ = strings.Cut(val, "=")
-			b, err := hex.DecodeString(strings.TrimSpace(val))
+func webhookInput(webhook *v1.Webhook, header http.Header, body []byte, vars map[string]any) ([]byte, error) {
+	if len(webhook.Spec.Mapping) > 0 {
+		input := make(map[string]json.RawMessage, len(webhook.Spec.Mapping))
+		for field, expr := range webhook.Spec.Mapping {
+			value, err := expression.EvaluateJSON(expr, vars)
 			if err != nil {
-				continue
+				return nil, types.NewErrBadRequest("failed to evaluate webhook mapping %q: %v", field, err)
 			}
+			input[field] = value
+		}
+		return json.Marshal(input)
+	}
 
-			h.Reset()
-			_, _ = h.Write(body)
+	var input struct {
+		Type    string            `json:"type"`
+		Payload string            `json:"payload"`
+		Headers map[string]string `json:"headers"`
+	}
 
-			if hmac.Equal(h.Sum(nil), b) {
-				return nil
-			}
+	input.Type = "webhook"
+	input.Payload = string(body)
+	input.Headers = make(map[string]string)
+
+	allHeaders := slices.Contains(webhook.Spec.Headers, "*")
+	for k := range header {
+		if !allHeaders && !slices.Contains(webhook.Spec.Headers, k) {
+			continue
 		}
+
+		input.Headers[k] = header.Get(k)
 	}
 
-	return fmt.Errorf("invalid secret header")
+	inputText, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal input: %w", err)
+	}
+	return inputText, nil
 }
 
 func validateManifest(req api.Context, manifest types.WebhookManifest) error {
@@ -317,9 +537,44 @@ func validateManifest(req api.Context, manifest types.WebhookManifest) error {
 		}
 	}
 
-	// On creation, the user must set both the validation header and secret or set neither.
-	if (manifest.ValidationHeader != "") != (manifest.Secret != "") {
-		return apierrors.NewBadRequest("webhook must have secret and header set together")
+	if err := validateSignatureManifest(manifest); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+
+	if manifest.Filter != "" {
+		if err := expression.Check(manifest.Filter, "payload", "headers"); err != nil {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid webhook filter: %v", err))
+		}
+	}
+
+	for field, expr := range manifest.Mapping {
+		if err := expression.Check(expr, "payload", "headers"); err != nil {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid webhook mapping %q: %v", field, err))
+		}
+	}
+
+	if manifest.RateLimit != nil {
+		if manifest.RateLimit.Requests <= 0 {
+			return apierrors.NewBadRequest("webhook rate limit requests must be greater than zero")
+		}
+		if manifest.RateLimit.Period != "" {
+			if period, err := time.ParseDuration(manifest.RateLimit.Period); err != nil || period <= 0 {
+				return apierrors.NewBadRequest(fmt.Sprintf("invalid webhook rate limit period %q", manifest.RateLimit.Period))
+			}
+		}
+	}
+
+	if manifest.IdempotencyWindow != "" {
+		if window, err := time.ParseDuration(manifest.IdempotencyWindow); err != nil || window <= 0 {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid webhook idempotency window %q", manifest.IdempotencyWindow))
+		}
+	}
+
+	if manifest.SynchronousTimeout != "" {
+		timeout, err := time.ParseDuration(manifest.SynchronousTimeout)
+		if err != nil || timeout <= 0 || timeout > maxSynchronousTimeout {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid webhook synchronous timeout %q, must be a duration up to %s", manifest.SynchronousTimeout, maxSynchronousTimeout))
+		}
 	}
 
 	return nil
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+const (
+	defaultReplayWindow = 5 * time.Minute
+
+	slackTimestampHeader = "X-Slack-Request-Timestamp"
+)
+
+// validateSignature checks the signature of a webhook delivery with the webhook's secret using its signature scheme.
+func validateSignature(manifest types.WebhookManifest, header http.Header, body []byte, now time.Time) error {
+	values := header.Values(manifest.SignatureHeader())
+
+	switch manifest.SignatureScheme {
+	case types.WebhookSignatureSchemeSlack:
+		timestamp := header.Get(slackTimestampHeader)
+		if err := validateTimestamp(manifest, timestamp, now); err != nil {
+			return err
+		}
+		var signatures []string
+		for _, v := range values {
+			if sig, ok := strings.CutPrefix(strings.TrimSpace(v), "v0="); ok {
+				signatures = append(signatures, sig)
+			}
+		}
+		return matchSignature(manifest.Secret, signedPayload("v0:"+timestamp+":", body), signatures, types.WebhookSignatureEncodingHex)
+	case types.WebhookSignatureSchemeStripe:
+		var timestamp string
+		var signatures []string
+		for _, v := range values {
+			for _, part := range strings.Split(v, ",") {
+				key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+				switch key {
+				case "t":
+					timestamp = value
+				case "v1":
+					signatures = append(signatures, value)
+				}
+			}
+		}
+		if err := validateTimestamp(manifest, timestamp, now); err != nil {
+			return err
+		}
+		return matchSignature(manifest.Secret, signedPayload(timestamp+".", body), signatures, types.WebhookSignatureEncodingHex)
+	case types.WebhookSignatureSchemeTimestampedHMAC:
+		timestamp := header.Get(manifest.TimestampHeader)
+		if err := validateTimestamp(manifest, timestamp, now); err != nil {
+			return err
+		}
+		return matchSignature(manifest.Secret, signedPayload(timestamp+".", body), splitSignatures(values), manifest.SignatureEncoding)
+	case types.WebhookSignatureSchemeGitHub:
+		return matchSignature(manifest.Secret, body, splitSignatures(values), types.WebhookSignatureEncodingHex)
+	default:
+		return matchSignature(manifest.Secret, body, splitSignatures(values), manifest.SignatureEncoding)
+	}
+}
+
+// splitSignatures returns the signatures in comma separated header values, removing prefixes such as "sha256=".
+func splitSignatures(values []string) (result []string) {
+	for _, v := range values {
+		for _, val := range strings.Split(v, ",") {
+			val = strings.TrimSpace(val)
+			// Only treat the "=" as a prefix separator if it isn't base64 padding.
+			if _, sig, ok := strings.Cut(val, "="); ok && strings.Trim(sig, "=") != "" {
+				val = sig
+			}
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func signedPayload(prefix string, body []byte) []byte {
+	return append([]byte(prefix), body...)
+}
+
+func matchSignature(secret string, message []byte, signatures []string, encoding types.WebhookSignatureEncoding) error {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = h.Write(message)
+	expected := h.Sum(nil)
+
+	for _, sig := range signatures {
+		var (
+			b   []byte
+			err error
+		)
+		if encoding == types.WebhookSignatureEncodingBase64 {
+			b, err = base64.StdEncoding.DecodeString(sig)
+		} else {
+			b, err = hex.DecodeString(sig)
+		}
+		if err != nil {
+			continue
+		}
+
+		if hmac.Equal(expected, b) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid secret header")
+}
+
+// validateTimestamp checks that the unix timestamp of a signed delivery is within the webhook's replay window.
+func validateTimestamp(manifest types.WebhookManifest, timestamp string, now time.Time) error {
+	if timestamp == "" {
+		return fmt.Errorf("missing signature timestamp")
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid signature timestamp %q", timestamp)
+	}
+
+	window := defaultReplayWindow
+	if manifest.ReplayWindow != "" {
+		if window, err = time.ParseDuration(manifest.ReplayWindow); err != nil {
+			return err
+		}
+	}
+
+	if age := now.Sub(time.Unix(seconds, 0)).Abs(); age > window {
+		return fmt.Errorf("signature timestamp is outside of the replay window of %s", window)
+	}
+	return nil
+}
+
+func validateSignatureManifest(manifest types.WebhookManifest) error {
+	switch manifest.SignatureScheme {
+	case "", types.WebhookSignatureSchemeHMAC, types.WebhookSignatureSchemeGitHub, types.WebhookSignatureSchemeSlack, types.WebhookSignatureSchemeStripe:
+	case types.WebhookSignatureSchemeTimestampedHMAC:
+		if manifest.TimestampHeader == "" {
+			return fmt.Errorf("signature scheme %s requires a timestamp header", manifest.SignatureScheme)
+		}
+	default:
+		return fmt.Errorf("unknown signature scheme %q", manifest.SignatureScheme)
+	}
+
+	switch manifest.SignatureEncoding {
+	case "", types.WebhookSignatureEncodingHex, types.WebhookSignatureEncodingBase64:
+	default:
+		return fmt.Errorf("unknown signature encoding %q", manifest.SignatureEncoding)
+	}
+
+	if manifest.ReplayWindow != "" {
+		if window, err := time.ParseDuration(manifest.ReplayWindow); err != nil || window <= 0 {
+			return fmt.Errorf("invalid replay window %q", manifest.ReplayWindow)
+		}
+	}
+
+	if manifest.SignatureScheme != "" && manifest.Secret == "" {
+		return fmt.Errorf("signature scheme %s requires a secret", manifest.SignatureScheme)
+	}
+
+	// On creation, the user must set both the validation header and secret or set neither.
+	if (manifest.SignatureHeader() != "") != (manifest.Secret != "") {
+		return fmt.Errorf("webhook must have secret and header set together")
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func TestValidateSignature(t *testing.T) {
+	const secret = "secret"
+	var (
+		body = []byte(`{"hello":"world"}`)
+		now  = time.Unix(1700000000, 0)
+	)
+
+	sign := func(message string) []byte {
+		h := hmac.New(sha256.New, []byte(secret))
+		_, _ = h.Write([]byte(message))
+		return h.Sum(nil)
+	}
+
+	tests := []struct {
+		name     string
+		manifest types.WebhookManifest
+		header   http.Header
+		valid    bool
+	}{
+		{
+			name:     "hmac",
+			manifest: types.WebhookManifest{ValidationHeader: "X-Signature"},
+			header:   http.Header{"X-Signature": {"sha256=" + hex.EncodeToString(sign(string(body)))}},
+			valid:    true,
+		},
+		{
+			name:     "hmac base64",
+			manifest: types.WebhookManifest{ValidationHeader: "X-Signature", SignatureEncoding: types.WebhookSignatureEncodingBase64},
+			header:   http.Header{"X-Signature": {base64.StdEncoding.EncodeToString(sign(string(body)))}},
+			valid:    true,
+		},
+		{
+			name:     "github wrong secret",
+			manifest: types.WebhookManifest{SignatureScheme: types.WebhookSignatureSchemeGitHub},
+			header:   http.Header{"X-Hub-Signature-256": {"sha256=" + hex.EncodeToString(sign("other"))}},
+		},
+		{
+			name:     "slack",
+			manifest: types.WebhookManifest{SignatureScheme: types.WebhookSignatureSchemeSlack},
+			header: http.Header{
+				"X-Slack-Signature":         {"v0=" + hex.EncodeToString(sign("v0:1700000000:"+string(body)))},
+				"X-Slack-Request-Timestamp": {"1700000000"},
+			},
+			valid: true,
+		},
+		{
+			name:     "slack replayed",
+			manifest: types.WebhookManifest{SignatureScheme: types.WebhookSignatureSchemeSlack},
+			header: http.Header{
+				"X-Slack-Signature":         {"v0=" + hex.EncodeToString(sign("v0:1699999000:"+string(body)))},
+				"X-Slack-Request-Timestamp": {"1699999000"},
+			},
+		},
+		{
+			name:     "stripe",
+			manifest: types.WebhookManifest{SignatureScheme: types.WebhookSignatureSchemeStripe},
+			header:   http.Header{"Stripe-Signature": {"t=1700000000,v1=bad,v1=" + hex.EncodeToString(sign("1700000000."+string(body)))}},
+			valid:    true,
+		},
+		{
+			name:     "timestamped hmac within replay window",
+			manifest: types.WebhookManifest{SignatureScheme: types.WebhookSignatureSchemeTimestampedHMAC, ValidationHeader: "X-Signature", TimestampHeader: "X-Timestamp", ReplayWindow: "1h"},
+			header: http.Header{
+				"X-Signature": {hex.EncodeToString(sign("1699999000." + string(body)))},
+				"X-Timestamp": {"1699999000"},
+			},
+			valid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.manifest.Secret = secret
+			err := validateSignature(tt.manifest, tt.header, body, now)
+			if tt.valid && err != nil {
+				t.Errorf("expected valid signature, got %v", err)
+			} else if !tt.valid && err == nil {
+				t.Error("expected invalid signature")
+			}
+		})
+	}
+}
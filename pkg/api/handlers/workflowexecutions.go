@@ -0,0 +1,348 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/invoke"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type WorkflowExecutionHandler struct {
+	invoker *invoke.Invoker
+}
+
+func NewWorkflowExecutionHandler(invoker *invoke.Invoker) *WorkflowExecutionHandler {
+	return &WorkflowExecutionHandler{
+		invoker: invoker,
+	}
+}
+
+func (a *WorkflowExecutionHandler) Resume(req api.Context) error {
+	var resume types.WorkflowExecutionResume
+	body, err := req.Body()
+	if err != nil {
+		return err
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &resume); err != nil {
+			return types.NewErrBadRequest("invalid resume request: %v", err)
+		}
+	}
+
+	var wfe v1.WorkflowExecution
+	if err := req.Get(&wfe, req.PathValue("id")); err != nil {
+		return err
+	}
+
+	if resume.FromStep == "" && wfe.Status.State != types.WorkflowStateError {
+		return types.NewErrBadRequest("workflow execution %s has not failed, a step to resume from is required", wfe.Name)
+	} else if !wfe.Status.State.IsTerminal() && !wfe.Status.State.IsBlocked() {
+		return types.NewErrBadRequest("workflow execution %s is still running", wfe.Name)
+	}
+
+	if resume.FromStep != "" && wfe.Status.WorkflowManifest != nil {
+		if step, _ := types.FindStep(wfe.Status.WorkflowManifest, resume.FromStep); step == nil {
+			return types.NewErrBadRequest("step %s not found in workflow execution %s", resume.FromStep, wfe.Name)
+		}
+	}
+
+	resumed, err := a.invoker.ResumeWorkflowExecution(req.Context(), req.Storage, wfe.Namespace, wfe.Name, resume.FromStep)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertWorkflowExecution(*resumed))
+}
+
+func (a *WorkflowExecutionHandler) Approve(req api.Context) error {
+	return a.decide(req, v1.ApprovalDecisionApproved)
+}
+
+func (a *WorkflowExecutionHandler) Reject(req api.Context) error {
+	return a.decide(req, v1.ApprovalDecisionRejected)
+}
+
+func (a *WorkflowExecutionHandler) decide(req api.Context, decision v1.ApprovalDecision) error {
+	var approval types.WorkflowExecutionApproval
+	body, err := req.Body()
+	if err != nil {
+		return err
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &approval); err != nil {
+			return types.NewErrBadRequest("invalid approval: %v", err)
+		}
+	}
+
+	step, err := pendingApproval(req, req.Namespace(), req.PathValue("id"), func(step *v1.WorkflowStep) bool {
+		return approval.StepID == "" || step.Spec.Step.ID == approval.StepID || normalizeStepID(step.Spec.Step.ID) == approval.StepID
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := recordDecision(req, step, decision, req.User.GetName(), approval.Comment); err != nil {
+		return err
+	}
+
+	req.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// approvalLinkPage confirms a decision made through an approval link. Opening the link only shows this page, so link
+// scanners and mail previews that follow it do not decide the approval.
+var approvalLinkPage = template.Must(template.New("approval").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Approval requested</title></head>
+<body>
+<h1>Approval requested</h1>
+{{- if .Message }}
+<p>{{ .Message }}</p>
+{{- end }}
+<p>Workflow execution {{ .WorkflowExecution }} is waiting for a decision.</p>
+<form method="post">
+<input type="hidden" name="token" value="{{ .Token }}">
+<p><textarea name="comment" rows="4" cols="60" placeholder="Comment (optional)"></textarea></p>
+<button type="submit" name="decision" value="approve">Approve</button>
+<button type="submit" name="decision" value="reject">Reject</button>
+</form>
+</body>
+</html>
+`))
+
+// ApprovalLinkPage handles the link that is emailed to approvers by showing a page to confirm the decision. The token
+// in the link authorizes the decision, so these requests do not need to be authenticated.
+func (a *WorkflowExecutionHandler) ApprovalLinkPage(req api.Context) error {
+	token := req.URL.Query().Get("token")
+	step, err := pendingApprovalForToken(req, token)
+	if err != nil {
+		return err
+	}
+
+	req.ResponseWriter.Header().Set("Content-Type", "text/html; charset=utf-8")
+	return approvalLinkPage.Execute(req.ResponseWriter, map[string]string{
+		"Message":           step.Spec.Step.Approval.Message,
+		"WorkflowExecution": step.Spec.WorkflowExecutionName,
+		"Token":             token,
+	})
+}
+
+// ApprovalLink decides the approval from the form of the approval link page. Anyone with the link can decide, so the
+// approver is recorded as the holder of the link.
+func (a *WorkflowExecutionHandler) ApprovalLink(req api.Context) error {
+	var decision v1.ApprovalDecision
+	switch strings.ToLower(req.FormValue("decision")) {
+	case "approve":
+		decision = v1.ApprovalDecisionApproved
+	case "reject":
+		decision = v1.ApprovalDecisionRejected
+	default:
+		return types.NewErrBadRequest("decision must be approve or reject")
+	}
+
+	step, err := pendingApprovalForToken(req, req.FormValue("token"))
+	if err != nil {
+		return err
+	}
+
+	if err := recordDecision(req, step, decision, "approval link holder", req.FormValue("comment")); err != nil {
+		return err
+	}
+
+	return req.Write(map[string]string{
+		"decision": string(decision),
+	})
+}
+
+func pendingApprovalForToken(req api.Context, token string) (*v1.WorkflowStep, error) {
+	if token == "" {
+		return nil, types.NewErrHttp(http.StatusForbidden, "missing approval token")
+	}
+	return pendingApproval(req, req.PathValue("namespace"), req.PathValue("id"), func(step *v1.WorkflowStep) bool {
+		return subtle.ConstantTimeCompare([]byte(step.Status.Approval.Token), []byte(token)) == 1
+	})
+}
+
+func pendingApproval(req api.Context, namespace, workflowExecutionName string, matches func(*v1.WorkflowStep) bool) (*v1.WorkflowStep, error) {
+	var steps v1.WorkflowStepList
+	if err := req.Storage.List(req.Context(), &steps, kclient.InNamespace(namespace), kclient.MatchingFields{
+		"spec.workflowExecutionName": workflowExecutionName,
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, step := range steps.Items {
+		if step.Spec.Step.Approval == nil || step.Status.State != types.WorkflowStateBlocked ||
+			step.Status.Approval == nil || step.Status.Approval.Decision != "" {
+			continue
+		}
+		if matches(&step) {
+			return &step, nil
+		}
+	}
+
+	return nil, types.NewErrNotFound("no pending approval found for workflow execution %s", workflowExecutionName)
+}
+
+func recordDecision(req api.Context, step *v1.WorkflowStep, decision v1.ApprovalDecision, approver, comment string) error {
+	step.Status.Approval.Decision = decision
+	step.Status.Approval.Approver = approver
+	step.Status.Approval.Comment = comment
+	step.Status.Approval.Time = metav1.Now()
+	return req.Storage.Status().Update(req.Context(), step)
+}
+
+func normalizeStepID(stepID string) string {
+	id, _, _ := strings.Cut(stepID, "{")
+	return id
+}
+
+func (a *WorkflowExecutionHandler) Get(req api.Context) error {
+	var wfe v1.WorkflowExecution
+	if err := req.Get(&wfe, req.PathValue("id")); err != nil {
+		return err
+	}
+
+	var steps v1.WorkflowStepList
+	if err := req.List(&steps, kclient.MatchingFields{
+		"spec.workflowExecutionName": wfe.Name,
+	}); err != nil {
+		return err
+	}
+
+	detail := types.WorkflowExecutionDetail{
+		WorkflowExecution: convertWorkflowExecution(wfe),
+	}
+
+	var err error
+	detail.Steps, err = workflowExecutionSteps(req, wfe.Status.WorkflowManifest, steps.Items)
+	if err != nil {
+		return err
+	}
+
+	for _, step := range detail.Steps {
+		detail.TokenUsage = detail.TokenUsage.Add(step.TokenUsage)
+	}
+
+	return req.Write(detail)
+}
+
+// workflowExecutionSteps arranges the steps of an execution into a tree. The steps created by if, while, for each and
+// parallel steps are nested under the step that created them.
+func workflowExecutionSteps(req api.Context, manifest *types.WorkflowManifest, steps []v1.WorkflowStep) ([]types.WorkflowExecutionStep, error) {
+	slices.SortFunc(steps, func(a, b v1.WorkflowStep) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	byID := make(map[string]*v1.WorkflowStep, len(steps))
+	for i := range steps {
+		byID[steps[i].Spec.Step.ID] = &steps[i]
+	}
+
+	children := map[string][]*v1.WorkflowStep{}
+	for i := range steps {
+		parentID := parentStepID(manifest, byID, steps, &steps[i])
+		children[parentID] = append(children[parentID], &steps[i])
+	}
+
+	var convert func(step *v1.WorkflowStep) (types.WorkflowExecutionStep, error)
+	convert = func(step *v1.WorkflowStep) (types.WorkflowExecutionStep, error) {
+		result := types.WorkflowExecutionStep{
+			ID:         step.Spec.Step.ID,
+			Name:       step.Spec.Step.Name,
+			State:      step.Status.State,
+			RunIDs:     step.Status.RunNames,
+			LastRunID:  step.Status.LastRunName,
+			Error:      step.Status.Error,
+			RetryCount: step.Status.RetryCount,
+		}
+		if step.Status.StartTime != nil {
+			result.StartTime = types.NewTime(step.Status.StartTime.Time)
+		}
+
+		var endTime time.Time
+		for _, runName := range step.Status.RunNames {
+			var run v1.Run
+			if err := req.Get(&run, runName); apierrors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return result, err
+			}
+			result.TokenUsage = result.TokenUsage.Add(run.Status.TokenUsage)
+			if run.Status.EndTime.After(endTime) {
+				endTime = run.Status.EndTime.Time
+			}
+		}
+
+		for _, child := range children[step.Spec.Step.ID] {
+			childStep, err := convert(child)
+			if err != nil {
+				return result, err
+			}
+			result.TokenUsage = result.TokenUsage.Add(childStep.TokenUsage)
+			if childStep.EndTime != nil && childStep.EndTime.Time.After(endTime) {
+				endTime = childStep.EndTime.Time
+			}
+			result.Steps = append(result.Steps, childStep)
+		}
+
+		if step.Status.State.IsTerminal() && !endTime.IsZero() {
+			result.EndTime = types.NewTime(endTime)
+		}
+		return result, nil
+	}
+
+	var result []types.WorkflowExecutionStep
+	for _, step := range children[""] {
+		converted, err := convert(step)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+
+	return result, nil
+}
+
+// parentStepID returns the ID of the step that created step, or "" for the top level steps of the workflow.
+func parentStepID(manifest *types.WorkflowManifest, byID map[string]*v1.WorkflowStep, steps []v1.WorkflowStep, step *v1.WorkflowStep) string {
+	id := step.Spec.Step.ID
+	lookupID := normalizeStepID(id)
+
+	// Steps such as {join} and {condition} are suffixed with the ID of the step that created them.
+	if lookupID != id {
+		if _, ok := byID[lookupID]; ok {
+			return lookupID
+		}
+	}
+
+	_, parentID := types.FindStep(manifest, lookupID)
+	if parentID == "" {
+		return ""
+	}
+	if _, ok := byID[parentID]; ok {
+		return parentID
+	}
+
+	// The parent is itself nested in a loop, so it has a suffix. Use the first instance of it.
+	for _, other := range steps {
+		if other.Spec.Step.ID != id && normalizeStepID(other.Spec.Step.ID) == parentID {
+			return other.Spec.Step.ID
+		}
+	}
+	return ""
+}
@@ -15,12 +15,14 @@ func Router(services *services.Services) (http.Handler, error) {
 	assistants := handlers.NewAssistantHandler(services.Invoker, services.Events, services.GPTClient)
 	tasks := handlers.NewTaskHandler(services.Invoker, services.Events)
 	workflows := handlers.NewWorkflowHandler(services.GPTClient, services.ServerURL, services.Invoker)
+	workflowExecutions := handlers.NewWorkflowExecutionHandler(services.Invoker)
 	invoker := handlers.NewInvokeHandler(services.Invoker)
 	threads := handlers.NewThreadHandler(services.GPTClient, services.Events)
 	runs := handlers.NewRunHandler(services.Events)
 	toolRefs := handlers.NewToolReferenceHandler(services.GPTClient)
 	webhooks := handlers.NewWebhookHandler()
 	cronJobs := handlers.NewCronJobHandler()
+	notificationTargets := handlers.NewNotificationTargetHandler()
 	models := handlers.NewModelHandler()
 	availableModels := handlers.NewAvailableModelsHandler(services.GPTClient, services.ModelProviderDispatcher)
 	modelProviders := handlers.NewModelProviderHandler(services.GPTClient, services.ModelProviderDispatcher)
@@ -158,6 +160,14 @@ func Router(services *services.Services) (http.Handler, error) {
 	mux.HandleFunc("POST /api/workflows/{id}/files/{file}", agents.UploadFile)
 	mux.HandleFunc("DELETE /api/workflows/{id}/files/{file}", agents.DeleteFile)
 
+	// Workflow executions
+	mux.HandleFunc("GET /api/workflow-executions/{id}", workflowExecutions.Get)
+	mux.HandleFunc("POST /api/workflow-executions/{id}/resume", workflowExecutions.Resume)
+	mux.HandleFunc("POST /api/workflow-executions/{id}/approve", workflowExecutions.Approve)
+	mux.HandleFunc("POST /api/workflow-executions/{id}/reject", workflowExecutions.Reject)
+	mux.HandleFunc("GET /api/workflow-executions/{namespace}/{id}/approval", workflowExecutions.ApprovalLinkPage)
+	mux.HandleFunc("POST /api/workflow-executions/{namespace}/{id}/approval", workflowExecutions.ApprovalLink)
+
 	// Invoker
 	mux.HandleFunc("POST /api/invoke/{id}", invoker.Invoke)
 	mux.HandleFunc("POST /api/invoke/{id}/thread/{thread}", invoker.Invoke)
@@ -227,6 +237,10 @@ func Router(services *services.Services) (http.Handler, error) {
 	mux.HandleFunc("DELETE /api/webhooks/{id}", webhooks.Delete)
 	mux.HandleFunc("PUT /api/webhooks/{id}", webhooks.Update)
 	mux.HandleFunc("POST /api/webhooks/{id}/remove-token", webhooks.RemoveToken)
+	mux.HandleFunc("POST /api/webhooks/{id}/actions/suspend", webhooks.Suspend)
+	mux.HandleFunc("POST /api/webhooks/{id}/actions/resume", webhooks.Resume)
+	mux.HandleFunc("GET /api/webhooks/{id}/deliveries", webhooks.Deliveries)
+	mux.HandleFunc("POST /api/webhooks/{id}/deliveries/{delivery_id}/replay", webhooks.ReplayDelivery)
 	mux.HandleFunc("POST /api/webhooks/{namespace}/{id}", webhooks.Execute)
 
 	// Email Receivers
@@ -235,6 +249,8 @@ func Router(services *services.Services) (http.Handler, error) {
 	mux.HandleFunc("GET /api/email-receivers/{id}", emailreceiver.ByID)
 	mux.HandleFunc("DELETE /api/email-receivers/{id}", emailreceiver.Delete)
 	mux.HandleFunc("PUT /api/email-receivers/{id}", emailreceiver.Update)
+	mux.HandleFunc("POST /api/email-receivers/{id}/actions/suspend", emailreceiver.Suspend)
+	mux.HandleFunc("POST /api/email-receivers/{id}/actions/resume", emailreceiver.Resume)
 
 	// Email Receivers for generic create
 	mux.HandleFunc("POST /api/emailreceivers", emailreceiver.Create)
@@ -247,6 +263,15 @@ func Router(services *services.Services) (http.Handler, error) {
 	mux.HandleFunc("DELETE /api/cronjobs/{id}", cronJobs.Delete)
 	mux.HandleFunc("PUT /api/cronjobs/{id}", cronJobs.Update)
 	mux.HandleFunc("POST /api/cronjobs/{id}", cronJobs.Execute)
+	mux.HandleFunc("POST /api/cronjobs/{id}/actions/suspend", cronJobs.Suspend)
+	mux.HandleFunc("POST /api/cronjobs/{id}/actions/resume", cronJobs.Resume)
+
+	// Notification targets
+	mux.HandleFunc("POST /api/notification-targets", notificationTargets.Create)
+	mux.HandleFunc("GET /api/notification-targets", notificationTargets.List)
+	mux.HandleFunc("GET /api/notification-targets/{id}", notificationTargets.ByID)
+	mux.HandleFunc("DELETE /api/notification-targets/{id}", notificationTargets.Delete)
+	mux.HandleFunc("PUT /api/notification-targets/{id}", notificationTargets.Update)
 
 	// debug
 	mux.HTTPHandle("GET /debug/pprof/", http.DefaultServeMux)
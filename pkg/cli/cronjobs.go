@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+)
+
+type CronJobs struct {
+	root   *Obot
+	Quiet  bool   `usage:"Only print IDs of cron jobs" short:"q"`
+	Wide   bool   `usage:"Print more information" short:"w"`
+	Output string `usage:"Output format (table, json, yaml)" short:"o" default:"table"`
+}
+
+func (l *CronJobs) Customize(cmd *cobra.Command) {
+	cmd.Aliases = []string{"cronjob", "cj"}
+}
+
+func (l *CronJobs) Run(cmd *cobra.Command, args []string) error {
+	var (
+		cjs types.CronJobList
+		err error
+	)
+
+	if len(args) > 0 {
+		for _, arg := range args {
+			cj, err := l.root.Client.GetCronJob(cmd.Context(), arg)
+			if err != nil {
+				return err
+			}
+			cjs.Items = append(cjs.Items, *cj)
+		}
+	} else {
+		cjs, err = l.root.Client.ListCronJobs(cmd.Context())
+		if err != nil {
+			return err
+		}
+	}
+
+	if ok, err := output(l.Output, cjs); ok || err != nil {
+		return err
+	}
+
+	if l.Quiet {
+		for _, cj := range cjs.Items {
+			fmt.Println(cj.ID)
+		}
+		return nil
+	}
+
+	w := newTable("ID", "DESCRIPTION", "WORKFLOW", "SCHEDULE", "SUSPENDED", "NEXTRUN", "LASTSUCCESS", "CREATED")
+	for _, cj := range cjs.Items {
+		w.WriteRow(cj.ID, truncate(cj.Description, l.Wide), cj.Workflow,
+			cronJobSchedule(cj),
+			fmt.Sprint(cj.Suspended),
+			humanize.Time(cj.NextRunAt.GetTime()),
+			humanize.Time(cj.LastSuccessfulRunCompleted.GetTime()),
+			humanize.Time(cj.Created.Time))
+	}
+
+	return w.Err()
+}
+
+// cronJobSchedule describes when the cron job runs, with its timezone if it has one.
+func cronJobSchedule(cj types.CronJob) string {
+	schedule, timezone := cj.Schedule, cj.Timezone
+	if cj.TaskSchedule != nil {
+		schedule = cj.TaskSchedule.Interval
+		if cj.TaskSchedule.Timezone != "" {
+			timezone = cj.TaskSchedule.Timezone
+		}
+	}
+	if timezone != "" {
+		return fmt.Sprintf("%s (%s)", schedule, timezone)
+	}
+	return schedule
+}
+
+type CronJobExecute struct {
+	root *Obot
+}
+
+func (l *CronJobExecute) Customize(cmd *cobra.Command) {
+	cmd.Use = "execute [flags] CRONJOB_ID..."
+	cmd.Aliases = []string{"exec", "run"}
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (l *CronJobExecute) Run(cmd *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if err := l.root.Client.ExecuteCronJob(cmd.Context(), arg); err != nil {
+			return err
+		}
+		fmt.Printf("Cron job %s executed\n", arg)
+	}
+	return nil
+}
+
+type CronJobSuspend struct {
+	root *Obot
+}
+
+func (l *CronJobSuspend) Customize(cmd *cobra.Command) {
+	cmd.Use = "suspend [flags] CRONJOB_ID..."
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (l *CronJobSuspend) Run(cmd *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if _, err := l.root.Client.SuspendCronJob(cmd.Context(), arg); err != nil {
+			return err
+		}
+		fmt.Printf("Cron job %s suspended\n", arg)
+	}
+	return nil
+}
+
+type CronJobResume struct {
+	root *Obot
+}
+
+func (l *CronJobResume) Customize(cmd *cobra.Command) {
+	cmd.Use = "resume [flags] CRONJOB_ID..."
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (l *CronJobResume) Run(cmd *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if _, err := l.root.Client.ResumeCronJob(cmd.Context(), arg); err != nil {
+			return err
+		}
+		fmt.Printf("Cron job %s resumed\n", arg)
+	}
+	return nil
+}
@@ -50,12 +50,51 @@ func (l *EmailReceivers) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	w := newTable("ID", "NAME", "DESCRIPTION", "WORKFLOW", "ADDRESS", "CREATED")
+	w := newTable("ID", "NAME", "DESCRIPTION", "WORKFLOW", "ADDRESS", "SUSPENDED", "CREATED")
 	for _, er := range ers.Items {
 		w.WriteRow(er.ID, er.Name, truncate(er.Description, l.Wide), er.Workflow,
 			er.EmailAddress,
+			fmt.Sprint(er.Suspended),
 			humanize.Time(er.Created.Time))
 	}
 
 	return w.Err()
 }
+
+type EmailReceiverSuspend struct {
+	root *Obot
+}
+
+func (l *EmailReceiverSuspend) Customize(cmd *cobra.Command) {
+	cmd.Use = "suspend [flags] EMAIL_RECEIVER_ID..."
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (l *EmailReceiverSuspend) Run(cmd *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if _, err := l.root.Client.SuspendEmailReceiver(cmd.Context(), arg); err != nil {
+			return err
+		}
+		fmt.Printf("Email receiver %s suspended\n", arg)
+	}
+	return nil
+}
+
+type EmailReceiverResume struct {
+	root *Obot
+}
+
+func (l *EmailReceiverResume) Customize(cmd *cobra.Command) {
+	cmd.Use = "resume [flags] EMAIL_RECEIVER_ID..."
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (l *EmailReceiverResume) Run(cmd *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if _, err := l.root.Client.ResumeEmailReceiver(cmd.Context(), arg); err != nil {
+			return err
+		}
+		fmt.Printf("Email receiver %s resumed\n", arg)
+	}
+	return nil
+}
@@ -61,6 +61,12 @@ func (l *Delete) Run(cmd *cobra.Command, args []string) error {
 			} else {
 				fmt.Printf("Email receiver deleted: %s\n", id)
 			}
+		case system.IsCronJobID(id):
+			if err := l.root.Client.DeleteCronJob(cmd.Context(), id); err != nil {
+				errs = append(errs, err)
+			} else {
+				fmt.Printf("Cron job deleted: %s\n", id)
+			}
 		default:
 			errs = append(errs, errors.New("invalid ID: "+id))
 		}
@@ -45,7 +45,10 @@ func New() *cobra.Command {
 		&Create{root: root},
 		&Agents{root: root},
 		cmd.Command(&Workflows{root: root},
-			&WorkflowAuth{root: root}),
+			&WorkflowAuth{root: root},
+			&WorkflowApprove{root: root},
+			&WorkflowReject{root: root},
+			cmd.Command(&WorkflowExecutions{root: root}, &WorkflowExecutionDescribe{root: root})),
 		&Edit{root: root},
 		&Update{root: root},
 		&Delete{root: root},
@@ -57,7 +60,12 @@ func New() *cobra.Command {
 			&ToolUnregister{root: root},
 			&ToolRegister{root: root},
 			&ToolUpdate{root: root}),
-		&Webhooks{root: root},
+		cmd.Command(&Webhooks{root: root}, &WebhookSuspend{root: root}, &WebhookResume{root: root}),
+		cmd.Command(&EmailReceivers{root: root}, &EmailReceiverSuspend{root: root}, &EmailReceiverResume{root: root}),
+		cmd.Command(&CronJobs{root: root},
+			&CronJobExecute{root: root},
+			&CronJobSuspend{root: root},
+			&CronJobResume{root: root}),
 		&Server{},
 		&Version{},
 	)
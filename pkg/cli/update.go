@@ -27,6 +27,23 @@ func (l *Update) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if system.IsCronJobID(id) {
+		var cronJobManifest types.CronJobManifest
+		if err := yaml.Unmarshal(data, &cronJobManifest); err != nil {
+			return err
+		}
+		cj, err := l.root.Client.UpdateCronJob(cmd.Context(), id, cronJobManifest)
+		if err != nil {
+			return err
+		}
+		if l.Quiet {
+			fmt.Println(cj.ID)
+			return nil
+		}
+		fmt.Printf("Cron job updated: %s\n", cj.ID)
+		return nil
+	}
+
 	var newManifest types.WorkflowManifest
 	if err := yaml.Unmarshal(data, &newManifest); err != nil {
 		return err
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+type WebhookSuspend struct {
+	root *Obot
+}
+
+func (l *WebhookSuspend) Customize(cmd *cobra.Command) {
+	cmd.Use = "suspend [flags] WEBHOOK_ID..."
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (l *WebhookSuspend) Run(cmd *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if _, err := l.root.Client.SuspendWebhook(cmd.Context(), arg); err != nil {
+			return err
+		}
+		fmt.Printf("Webhook %s suspended\n", arg)
+	}
+	return nil
+}
+
+type WebhookResume struct {
+	root *Obot
+}
+
+func (l *WebhookResume) Customize(cmd *cobra.Command) {
+	cmd.Use = "resume [flags] WEBHOOK_ID..."
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (l *WebhookResume) Run(cmd *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if _, err := l.root.Client.ResumeWebhook(cmd.Context(), arg); err != nil {
+			return err
+		}
+		fmt.Printf("Webhook %s resumed\n", arg)
+	}
+	return nil
+}
@@ -51,9 +51,10 @@ func (l *Webhooks) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	w := newTable("ID", "NAME", "DESCRIPTION", "WORKFLOW", "LASTRUN", "CREATED")
+	w := newTable("ID", "NAME", "DESCRIPTION", "WORKFLOW", "SUSPENDED", "LASTRUN", "CREATED")
 	for _, wh := range whs.Items {
 		w.WriteRow(wh.ID, wh.Name, truncate(wh.Description, l.Wide), wh.Workflow,
+			fmt.Sprint(wh.Suspended),
 			humanize.Time(wh.LastSuccessfulRunCompleted.GetTime()),
 			humanize.Time(wh.Created.Time))
 	}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+)
+
+type WorkflowApprove struct {
+	root    *Obot
+	Step    string `usage:"ID of the approval step, required if more than one step is waiting for approval"`
+	Comment string `usage:"Comment passed on to the next step" short:"m"`
+}
+
+func (l *WorkflowApprove) Customize(cmd *cobra.Command) {
+	cmd.Use = "approve [flags] WORKFLOW_EXECUTION_ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (l *WorkflowApprove) Run(cmd *cobra.Command, args []string) error {
+	if err := l.root.Client.ApproveWorkflowExecution(cmd.Context(), args[0], types.WorkflowExecutionApproval{
+		StepID:  l.Step,
+		Comment: l.Comment,
+	}); err != nil {
+		return err
+	}
+	fmt.Printf("Workflow execution %s approved\n", args[0])
+	return nil
+}
+
+type WorkflowReject struct {
+	root    *Obot
+	Step    string `usage:"ID of the approval step, required if more than one step is waiting for approval"`
+	Comment string `usage:"Reason for rejecting" short:"m"`
+}
+
+func (l *WorkflowReject) Customize(cmd *cobra.Command) {
+	cmd.Use = "reject [flags] WORKFLOW_EXECUTION_ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (l *WorkflowReject) Run(cmd *cobra.Command, args []string) error {
+	if err := l.root.Client.RejectWorkflowExecution(cmd.Context(), args[0], types.WorkflowExecutionApproval{
+		StepID:  l.Step,
+		Comment: l.Comment,
+	}); err != nil {
+		return err
+	}
+	fmt.Printf("Workflow execution %s rejected\n", args[0])
+	return nil
+}
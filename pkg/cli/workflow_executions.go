@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/obot-platform/obot/apiclient"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+)
+
+type WorkflowExecutions struct {
+	root   *Obot
+	Quiet  bool   `usage:"Only print IDs of workflow executions" short:"q"`
+	Wide   bool   `usage:"Print more information" short:"w"`
+	Output string `usage:"Output format (table, json, yaml)" short:"o" default:"table"`
+}
+
+func (l *WorkflowExecutions) Customize(cmd *cobra.Command) {
+	cmd.Use = "executions [flags] WORKFLOW_ID"
+	cmd.Aliases = []string{"execution", "wfe"}
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (l *WorkflowExecutions) Run(cmd *cobra.Command, args []string) error {
+	wfes, err := l.root.Client.ListWorkflowExecutions(cmd.Context(), args[0], apiclient.ListWorkflowExecutionsOptions{})
+	if err != nil {
+		return err
+	}
+
+	if ok, err := output(l.Output, wfes); ok || err != nil {
+		return err
+	}
+
+	if l.Quiet {
+		for _, wfe := range wfes.Items {
+			fmt.Println(wfe.ID)
+		}
+		return nil
+	}
+
+	w := newTable("ID", "STATE", "INPUT", "DURATION", "ERROR", "CREATED")
+	for _, wfe := range wfes.Items {
+		w.WriteRow(wfe.ID, string(wfe.State), truncate(wfe.Input, l.Wide), duration(&wfe.StartTime, wfe.EndTime),
+			truncate(wfe.Error, l.Wide), humanize.Time(wfe.Created.Time))
+	}
+
+	return w.Err()
+}
+
+type WorkflowExecutionDescribe struct {
+	root   *Obot
+	Wide   bool   `usage:"Print more information" short:"w"`
+	Output string `usage:"Output format (table, json, yaml)" short:"o" default:"table"`
+}
+
+func (l *WorkflowExecutionDescribe) Customize(cmd *cobra.Command) {
+	cmd.Use = "describe [flags] WORKFLOW_EXECUTION_ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (l *WorkflowExecutionDescribe) Run(cmd *cobra.Command, args []string) error {
+	wfe, err := l.root.Client.GetWorkflowExecution(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	if ok, err := output(l.Output, wfe); ok || err != nil {
+		return err
+	}
+
+	fmt.Printf("ID:       %s\n", wfe.ID)
+	fmt.Printf("State:    %s\n", wfe.State)
+	fmt.Printf("Started:  %s\n", humanize.Time(wfe.StartTime.Time))
+	fmt.Printf("Duration: %s\n", duration(&wfe.StartTime, wfe.EndTime))
+	fmt.Printf("Tokens:   %d\n", wfe.TokenUsage.TotalTokens)
+	if wfe.Error != "" {
+		fmt.Printf("Error:    %s\n", truncate(wfe.Error, l.Wide))
+	}
+	fmt.Println()
+
+	w := newTable("STEP", "STATE", "STARTED", "DURATION", "RUNS", "RETRIES", "TOKENS", "ERROR")
+	var writeSteps func(steps []types.WorkflowExecutionStep, depth int)
+	writeSteps = func(steps []types.WorkflowExecutionStep, depth int) {
+		for _, step := range steps {
+			started := ""
+			if step.StartTime != nil {
+				started = humanize.Time(step.StartTime.Time)
+			}
+			w.WriteRow(strings.Repeat("  ", depth)+step.ID, string(step.State), started, duration(step.StartTime, step.EndTime),
+				fmt.Sprint(len(step.RunIDs)), fmt.Sprint(step.RetryCount), fmt.Sprint(step.TokenUsage.TotalTokens), truncate(step.Error, l.Wide))
+			writeSteps(step.Steps, depth+1)
+		}
+	}
+	writeSteps(wfe.Steps, 0)
+
+	return w.Err()
+}
+
+// duration returns the time between start and end, or since start if it has not ended.
+func duration(start, end *types.Time) string {
+	if start == nil || start.Time.IsZero() {
+		return ""
+	}
+	if end == nil {
+		return time.Since(start.Time).Round(time.Second).String()
+	}
+	return end.Time.Sub(start.Time).Round(time.Second).String()
+}
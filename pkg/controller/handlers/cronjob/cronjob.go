@@ -2,7 +2,10 @@ package cronjob
 
 import (
 	"fmt"
+	"strings"
 	"time"
+	// Embed the timezone database so that schedules can be evaluated in any timezone.
+	_ "time/tzdata"
 
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -15,12 +18,38 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// maxCatchUpRuns is the most missed runs started when catching up.
+	maxCatchUpRuns = 10
+	// maxScheduleIterations bounds the search for missed times of frequent schedules after a long downtime.
+	maxScheduleIterations = 100_000
+)
+
 type Handler struct{}
 
 func New() *Handler {
 	return &Handler{}
 }
 
+var scheduleParser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseSchedule parses a cron expression with optional seconds, a CRON_TZ= prefix, or a descriptor such as "@daily"
+// or "@every 1h30m". The expression is evaluated in the timezone unless it sets its own.
+func ParseSchedule(schedule, timezone string) (cron.Schedule, error) {
+	if timezone != "" && !strings.HasPrefix(schedule, "CRON_TZ=") && !strings.HasPrefix(schedule, "TZ=") {
+		schedule = "CRON_TZ=" + timezone + " " + schedule
+	}
+	return scheduleParser.Parse(schedule)
+}
+
+// GetTimezone returns the timezone the schedule of the cron job is evaluated in. An empty timezone is the server's.
+func GetTimezone(cronJob v1.CronJob) string {
+	if cronJob.Spec.TaskSchedule != nil {
+		return cronJob.Spec.TaskSchedule.Timezone
+	}
+	return cronJob.Spec.Timezone
+}
+
 func GetSchedule(cronJob v1.CronJob) string {
 	if cronJob.Spec.TaskSchedule != nil {
 		switch cronJob.Spec.TaskSchedule.Interval {
@@ -39,21 +68,69 @@ func GetSchedule(cronJob v1.CronJob) string {
 
 func (h *Handler) Run(req router.Request, resp router.Response) error {
 	cj := req.Object.(*v1.CronJob)
-	lastRun := cj.Status.LastRunStartedAt
-	if lastRun.IsZero() {
-		lastRun = &cj.CreationTimestamp
+	lastScheduled := cj.Status.LastScheduleTime
+	if lastScheduled.IsZero() {
+		lastScheduled = cj.Status.LastRunStartedAt
+	}
+	if lastScheduled.IsZero() {
+		lastScheduled = &cj.CreationTimestamp
 	}
 
-	sched, err := cron.ParseStandard(GetSchedule(*cj))
+	sched, err := ParseSchedule(GetSchedule(*cj), GetTimezone(*cj))
 	if err != nil {
 		return fmt.Errorf("failed to parse schedule: %w", err)
 	}
 
-	if until := time.Until(sched.Next(lastRun.Time)); until > 0 {
-		resp.RetryAfter(until)
+	now := time.Now()
+	if cj.Spec.Suspended {
+		// Skip the scheduled times while suspended so that they are not caught up once resumed.
+		next := sched.Next(lastScheduled.Time)
+		if !next.IsZero() && !next.After(now) {
+			cj.Status.LastScheduleTime = &metav1.Time{Time: now}
+			next = sched.Next(now)
+		}
+		if !next.IsZero() {
+			resp.RetryAfter(next.Sub(now))
+		}
+		return nil
+	}
+
+	scheduled, remaining := nextScheduledTime(sched, lastScheduled.Time, now, cj.Spec.CatchUp)
+	if scheduled.IsZero() {
+		if next := sched.Next(lastScheduled.Time); !next.IsZero() {
+			resp.RetryAfter(next.Sub(now))
+		}
 		return nil
 	}
 
+	// Whatever happens to this scheduled time, the next reconcile moves on to the following one.
+	cj.Status.LastScheduleTime = &metav1.Time{Time: scheduled}
+	if remaining {
+		resp.RetryAfter(time.Second)
+	}
+
+	if cj.Spec.StartingDeadline != "" {
+		if deadline, err := time.ParseDuration(cj.Spec.StartingDeadline); err == nil && now.Sub(scheduled) > deadline {
+			// Too late to start the run, skip it.
+			return nil
+		}
+	}
+
+	if cj.Spec.ConcurrencyPolicy == types.CronJobConcurrencyPolicyForbid || cj.Spec.ConcurrencyPolicy == types.CronJobConcurrencyPolicyReplace {
+		active, err := activeExecutions(req, cj)
+		if err != nil {
+			return err
+		}
+		if len(active) > 0 && cj.Spec.ConcurrencyPolicy == types.CronJobConcurrencyPolicyForbid {
+			return nil
+		}
+		for _, execution := range active {
+			if err := req.Delete(&execution); kclient.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+
 	var workflow v1.Workflow
 	if err := alias.Get(req.Ctx, req.Client, &workflow, cj.Namespace, cj.Spec.Workflow); err != nil {
 		return err
@@ -81,6 +158,48 @@ func (h *Handler) Run(req router.Request, resp router.Response) error {
 	return nil
 }
 
+// nextScheduledTime returns the scheduled time to run for, or a zero time if no run is due. Without catch-up, the
+// most recent of the missed times is run. With catch-up, the missed times are run in order, up to maxCatchUpRuns of
+// the most recent ones, and remaining is true if there are more after the returned time.
+func nextScheduledTime(sched cron.Schedule, last, now time.Time, catchUp bool) (scheduled time.Time, remaining bool) {
+	var missed []time.Time
+	for t, i := sched.Next(last), 0; !t.IsZero() && !t.After(now) && i < maxScheduleIterations; t, i = sched.Next(t), i+1 {
+		missed = append(missed, t)
+		if len(missed) > maxCatchUpRuns {
+			missed = missed[1:]
+		}
+	}
+
+	switch {
+	case len(missed) == 0:
+		return time.Time{}, false
+	case catchUp:
+		return missed[0], len(missed) > 1
+	default:
+		return missed[len(missed)-1], false
+	}
+}
+
+// activeExecutions returns the executions of the cron job that are still running. Blocked executions, such as those
+// waiting for an approval, are not counted, because they can wait indefinitely and would stop the cron job for as long.
+func activeExecutions(req router.Request, cj *v1.CronJob) ([]v1.WorkflowExecution, error) {
+	var workflowExecutions v1.WorkflowExecutionList
+	if err := req.List(&workflowExecutions, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.cronJobName": cj.Name}),
+		Namespace:     cj.Namespace,
+	}); err != nil {
+		return nil, err
+	}
+
+	var active []v1.WorkflowExecution
+	for _, execution := range workflowExecutions.Items {
+		if !execution.Status.State.IsTerminal() && execution.Status.State != types.WorkflowStateBlocked && execution.DeletionTimestamp.IsZero() {
+			active = append(active, execution)
+		}
+	}
+	return active, nil
+}
+
 func (h *Handler) SetSuccessRunTime(req router.Request, _ router.Response) error {
 	cj := req.Object.(*v1.CronJob)
 
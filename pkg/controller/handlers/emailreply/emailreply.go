@@ -0,0 +1,133 @@
+package emailreply
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gz"
+	"github.com/obot-platform/obot/pkg/smtp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	maxAttempts    = 8
+	initialBackoff = 30 * time.Second
+	maxBackoff     = time.Hour
+)
+
+type Handler struct {
+	relay *smtp.Relay
+}
+
+func New(relay *smtp.Relay) *Handler {
+	return &Handler{
+		relay: relay,
+	}
+}
+
+// Reply sends the output of a completed workflow execution back to the sender of the email that started it. The reply
+// is sent once for each generation, so a continued execution replies again. A reply that the relay fails to take is
+// retried with backoff, and the generation is only recorded once the relay takes it or rejects it for good.
+func (h *Handler) Reply(req router.Request, resp router.Response) error {
+	wfe := req.Object.(*v1.WorkflowExecution)
+
+	reply := wfe.Spec.EmailReply
+	if reply == nil || wfe.Status.State != types.WorkflowStateComplete ||
+		wfe.Status.WorkflowGeneration != wfe.Spec.WorkflowGeneration ||
+		wfe.Status.EmailReplyGeneration == wfe.Status.WorkflowGeneration {
+		return nil
+	}
+
+	if h.relay == nil {
+		finishReply(wfe, "no outbound SMTP relay is configured")
+		return nil
+	}
+
+	to, err := mail.ParseAddress(reply.To)
+	if err != nil {
+		finishReply(wfe, fmt.Sprintf("invalid reply address %q: %v", reply.To, err))
+		return nil
+	}
+
+	if wfe.Status.EmailReplyLastAttemptAt != nil {
+		if wait := time.Until(wfe.Status.EmailReplyLastAttemptAt.Add(backoff(wfe.Status.EmailReplyAttempts))); wait > 0 {
+			resp.RetryAfter(wait)
+			return nil
+		}
+	}
+
+	output, err := workflowExecutionOutput(req.Ctx, req.Client, wfe)
+	if err != nil {
+		return err
+	}
+
+	message, err := smtp.Reply{
+		From:       reply.From,
+		To:         reply.To,
+		Subject:    reply.Subject,
+		MessageID:  smtp.ReplyMessageID(wfe.Name, wfe.Status.WorkflowGeneration, reply.From),
+		InReplyTo:  reply.InReplyTo,
+		References: reply.References,
+		Body:       output,
+	}.Bytes()
+	if err != nil {
+		return err
+	}
+
+	err = h.relay.Send(req.Ctx, reply.From, []string{to.Address}, message)
+	switch {
+	case err == nil:
+		finishReply(wfe, "")
+	case smtp.IsPermanentError(err) || wfe.Status.EmailReplyAttempts+1 >= maxAttempts:
+		finishReply(wfe, err.Error())
+	default:
+		now := metav1.Now()
+		wfe.Status.EmailReplyAttempts++
+		wfe.Status.EmailReplyLastAttemptAt = &now
+		wfe.Status.EmailReplyError = err.Error()
+		resp.RetryAfter(backoff(wfe.Status.EmailReplyAttempts))
+	}
+
+	return nil
+}
+
+// finishReply records that the reply for the current generation is done, either because it was sent or because it can't
+// be, and resets the attempts for the next generation.
+func finishReply(wfe *v1.WorkflowExecution, replyError string) {
+	wfe.Status.EmailReplyGeneration = wfe.Status.WorkflowGeneration
+	wfe.Status.EmailReplyError = replyError
+	wfe.Status.EmailReplyAttempts = 0
+	wfe.Status.EmailReplyLastAttemptAt = nil
+}
+
+func backoff(attempts int) time.Duration {
+	d := initialBackoff
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxBackoff)
+}
+
+func workflowExecutionOutput(ctx context.Context, c kclient.Client, wfe *v1.WorkflowExecution) (string, error) {
+	if wfe.Status.LastRunName == "" {
+		return wfe.Status.Output, nil
+	}
+
+	var (
+		runState v1.RunState
+		output   string
+	)
+	if err := c.Get(ctx, router.Key(wfe.Namespace, wfe.Status.LastRunName), &runState); apierrors.IsNotFound(err) {
+		return wfe.Status.Output, nil
+	} else if err != nil {
+		return "", err
+	}
+	return output, gz.Decompress(&output, runState.Spec.Output)
+}
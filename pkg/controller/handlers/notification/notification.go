@@ -0,0 +1,368 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gz"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	maxAttempts       = 8
+	initialBackoff    = 30 * time.Second
+	maxBackoff        = time.Hour
+	requestTimeout    = 30 * time.Second
+	deliveryRetention = 7 * 24 * time.Hour
+
+	SignatureHeader = "X-Obot-Signature"
+	TimestampHeader = "X-Obot-Timestamp"
+	EventHeader     = "X-Obot-Event"
+	DeliveryHeader  = "X-Obot-Delivery"
+)
+
+type Handler struct {
+	client *http.Client
+}
+
+func New() *Handler {
+	return &Handler{
+		client: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+// WorkflowExecution queues notifications for a workflow execution that completed, failed, or is waiting for approval.
+func (h *Handler) WorkflowExecution(req router.Request, _ router.Response) error {
+	wfe := req.Object.(*v1.WorkflowExecution)
+
+	var event types.NotificationEvent
+	switch wfe.Status.State {
+	case types.WorkflowStateComplete:
+		event = types.NotificationEventComplete
+	case types.WorkflowStateError:
+		event = types.NotificationEventError
+	case types.WorkflowStateBlocked:
+		event = types.NotificationEventBlocked
+	default:
+		return nil
+	}
+
+	if wfe.Status.State.IsTerminal() && wfe.Status.WorkflowGeneration != wfe.Spec.WorkflowGeneration {
+		// The state is left over from the previous run of a workflow that is running again.
+		return nil
+	}
+
+	payload := types.NotificationPayload{
+		Event:               event,
+		WorkflowID:          wfe.Spec.WorkflowName,
+		WorkflowExecutionID: wfe.Name,
+		ThreadID:            wfe.Status.ThreadName,
+		State:               string(wfe.Status.State),
+		Output:              wfe.Status.Output,
+		Error:               wfe.Status.Error,
+	}
+
+	if event == types.NotificationEventComplete && wfe.Status.LastRunName != "" {
+		output, err := runOutput(req.Ctx, req.Client, wfe.Namespace, wfe.Status.LastRunName)
+		if err != nil {
+			return err
+		} else if output != "" {
+			payload.Output = output
+		}
+	}
+
+	// Each run of the workflow notifies again, but changes to the execution that don't run the workflow, such as
+	// reassigning its thread, don't. Each step that blocks a run notifies once.
+	sourceKey := fmt.Sprintf("%s-%d", wfe.Name, wfe.Spec.WorkflowGeneration)
+	if event == types.NotificationEventBlocked {
+		stepName, err := blockedStepName(req, wfe)
+		if err != nil {
+			return err
+		}
+		sourceKey += "-" + stepName
+	}
+
+	return h.queue(req, sourceKey, wfe.Status.EndTime, payload)
+}
+
+// blockedStepName returns the name of the step that is blocking the current run of the workflow execution.
+func blockedStepName(req router.Request, wfe *v1.WorkflowExecution) (string, error) {
+	var steps v1.WorkflowStepList
+	if err := req.List(&steps, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.workflowExecutionName": wfe.Name}),
+		Namespace:     wfe.Namespace,
+	}); err != nil {
+		return "", err
+	}
+
+	var names []string
+	for _, step := range steps.Items {
+		if step.Spec.WorkflowGeneration == wfe.Spec.WorkflowGeneration && step.Status.State.IsBlocked() {
+			names = append(names, step.Name)
+		}
+	}
+	slices.Sort(names)
+	return strings.Join(names, "-"), nil
+}
+
+// Run queues notifications for a run that needs the user to log in, and for a run that is not part of a workflow
+// execution that completed or failed.
+func (h *Handler) Run(req router.Request, _ router.Response) error {
+	run := req.Object.(*v1.Run)
+
+	var (
+		event   types.NotificationEvent
+		endTime *metav1.Time
+	)
+	switch {
+	case run.Status.NeedsAuth && !run.Status.State.IsTerminal() && run.Status.State != gptscript.Continue:
+		event = types.NotificationEventNeedsAuth
+	case run.Spec.WorkflowExecutionName != "" || run.Spec.AgentName == "":
+		// Workflow runs are notified through their workflow execution, and runs without an agent are system tasks.
+		return nil
+	case run.Status.State == gptscript.Continue || run.Status.State == gptscript.Finished:
+		event, endTime = types.NotificationEventComplete, &run.Status.EndTime
+	case run.Status.State == gptscript.Error:
+		event, endTime = types.NotificationEventError, &run.Status.EndTime
+	default:
+		return nil
+	}
+
+	payload := types.NotificationPayload{
+		Event:               event,
+		WorkflowID:          run.Spec.WorkflowName,
+		WorkflowExecutionID: run.Spec.WorkflowExecutionName,
+		AgentID:             run.Spec.AgentName,
+		ThreadID:            run.Spec.ThreadName,
+		RunID:               run.Name,
+		State:               string(run.Status.State),
+		Output:              run.Status.Output,
+		Error:               run.Status.Error,
+		AuthURL:             run.Status.AuthURL,
+	}
+
+	if event == types.NotificationEventComplete {
+		output, err := runOutput(req.Ctx, req.Client, run.Namespace, run.Name)
+		if err != nil {
+			return err
+		} else if output != "" {
+			payload.Output = output
+		}
+	}
+
+	return h.queue(req, run.Name, endTime, payload)
+}
+
+// queue creates a delivery of the event for each notification target that wants it. The delivery names are derived
+// from the event so that an event is only queued once. Events that happened before a target was created, or that
+// are older than the deliveries are kept, are not sent.
+func (h *Handler) queue(req router.Request, sourceKey string, eventTime *metav1.Time, payload types.NotificationPayload) error {
+	if eventTime != nil && !eventTime.IsZero() && time.Since(eventTime.Time) > deliveryRetention {
+		return nil
+	}
+
+	var targets v1.NotificationTargetList
+	if err := req.List(&targets, &kclient.ListOptions{
+		Namespace: req.Namespace,
+	}); err != nil {
+		return err
+	}
+
+	for _, target := range targets.Items {
+		if !target.DeletionTimestamp.IsZero() || !target.WantsEvent(payload.Event) {
+			continue
+		}
+		if eventTime != nil && eventTime.Before(&target.CreationTimestamp) {
+			continue
+		}
+
+		deliveryName := name.SafeHashConcatName(system.NotificationDeliveryPrefix, target.Name, sourceKey, string(payload.Event))
+		if err := req.Get(&v1.NotificationDelivery{}, req.Namespace, deliveryName); err == nil {
+			continue
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		payload.ID = deliveryName
+		payload.Time = *types.NewTime(time.Now())
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+
+		if err := req.Client.Create(req.Ctx, &v1.NotificationDelivery{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      deliveryName,
+				Namespace: req.Namespace,
+			},
+			Spec: v1.NotificationDeliverySpec{
+				NotificationTargetName: target.Name,
+				Event:                  payload.Event,
+				SourceName:             req.Name,
+				Payload:                data,
+			},
+		}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Deliver sends a notification to its target, retrying with backoff. Deliveries are deleted after the retention
+// period.
+func (h *Handler) Deliver(req router.Request, resp router.Response) error {
+	delivery := req.Object.(*v1.NotificationDelivery)
+
+	if delivery.Status.DeliveredAt != nil || delivery.Status.Failed {
+		age := time.Since(delivery.CreationTimestamp.Time)
+		if age > deliveryRetention {
+			return kclient.IgnoreNotFound(req.Delete(delivery))
+		}
+		resp.RetryAfter(deliveryRetention - age)
+		return nil
+	}
+
+	if delivery.Status.LastAttemptAt != nil {
+		if wait := time.Until(delivery.Status.LastAttemptAt.Add(backoff(delivery.Status.Attempts))); wait > 0 {
+			resp.RetryAfter(wait)
+			return nil
+		}
+	}
+
+	var target v1.NotificationTarget
+	if err := req.Get(&target, delivery.Namespace, delivery.Spec.NotificationTargetName); apierrors.IsNotFound(err) {
+		// The delivery is cleaned up with its target.
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	statusCode, err := h.send(req.Ctx, &target, delivery)
+
+	now := metav1.Now()
+	delivery.Status.Attempts++
+	delivery.Status.LastAttemptAt = &now
+	delivery.Status.StatusCode = statusCode
+	delivery.Status.Error = ""
+
+	switch {
+	case err == nil:
+		delivery.Status.DeliveredAt = &now
+	case !retryable(statusCode) || delivery.Status.Attempts >= maxAttempts:
+		delivery.Status.Error = err.Error()
+		delivery.Status.Failed = true
+	default:
+		delivery.Status.Error = err.Error()
+		resp.RetryAfter(backoff(delivery.Status.Attempts))
+	}
+
+	return nil
+}
+
+func (h *Handler) send(ctx context.Context, target *v1.NotificationTarget, delivery *v1.NotificationDelivery) (int, error) {
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, target.Spec.URL, bytes.NewReader(delivery.Spec.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	for k, v := range target.Spec.Headers {
+		r.Header.Set(k, v)
+	}
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set(EventHeader, string(delivery.Spec.Event))
+	r.Header.Set(DeliveryHeader, delivery.Name)
+
+	if target.Spec.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(target.Spec.Secret))
+		_, _ = mac.Write([]byte(timestamp + "."))
+		_, _ = mac.Write(delivery.Spec.Payload)
+		r.Header.Set(TimestampHeader, timestamp)
+		r.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := h.client.Do(r)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("notification target responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// SetLastDelivery records the result of the latest delivery attempt on the notification target.
+func (h *Handler) SetLastDelivery(req router.Request, _ router.Response) error {
+	target := req.Object.(*v1.NotificationTarget)
+
+	var deliveries v1.NotificationDeliveryList
+	if err := req.List(&deliveries, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.notificationTargetName": target.Name}),
+		Namespace:     target.Namespace,
+	}); err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries.Items {
+		if delivery.Status.LastAttemptAt == nil {
+			continue
+		}
+		if target.Status.LastDeliveryAt == nil || target.Status.LastDeliveryAt.Before(delivery.Status.LastAttemptAt) {
+			target.Status.LastDeliveryAt = delivery.Status.LastAttemptAt
+			target.Status.LastDeliveryError = delivery.Status.Error
+		}
+	}
+
+	return nil
+}
+
+func retryable(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+func backoff(attempts int) time.Duration {
+	d := initialBackoff
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxBackoff)
+}
+
+func runOutput(ctx context.Context, c kclient.Client, namespace, runName string) (string, error) {
+	var (
+		runState v1.RunState
+		output   string
+	)
+	if err := c.Get(ctx, router.Key(namespace, runName), &runState); apierrors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return output, gz.Decompress(&output, runState.Spec.Output)
+}
@@ -0,0 +1,95 @@
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWorkflowExecutionNotifiesOncePerRunAndBlockedStep(t *testing.T) {
+	target := &v1.NotificationTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "nt1", Namespace: "default"},
+	}
+	approval1 := blockedStep("ws1-approve1")
+	approval2 := blockedStep("ws1-approve2")
+	approval2.Status.State = types.WorkflowStatePending
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(target, approval1, approval2).
+		WithIndex(&v1.WorkflowStep{}, "spec.workflowExecutionName", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.WorkflowStep).Spec.WorkflowExecutionName}
+		}).Build()
+
+	wfe := &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: "we1", Namespace: "default", Generation: 1},
+		Spec:       v1.WorkflowExecutionSpec{WorkflowGeneration: 1},
+		Status:     v1.WorkflowExecutionStatus{State: types.WorkflowStateBlocked},
+	}
+	h := New()
+	notify := func() {
+		t.Helper()
+		if err := h.WorkflowExecution(router.Request{
+			Ctx:       context.Background(),
+			Client:    c,
+			Object:    wfe,
+			Namespace: wfe.Namespace,
+			Name:      wfe.Name,
+		}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	deliveries := func() int {
+		t.Helper()
+		var list v1.NotificationDeliveryList
+		if err := c.List(context.Background(), &list); err != nil {
+			t.Fatal(err)
+		}
+		return len(list.Items)
+	}
+
+	notify()
+	notify()
+	if n := deliveries(); n != 1 {
+		t.Fatalf("expected 1 delivery for the first approval, got %d", n)
+	}
+
+	// A second approval in the same run is notified too.
+	approval1.Status.State = types.WorkflowStateComplete
+	approval2.Status.State = types.WorkflowStateBlocked
+	for _, step := range []*v1.WorkflowStep{approval1, approval2} {
+		if err := c.Update(context.Background(), step); err != nil {
+			t.Fatal(err)
+		}
+	}
+	notify()
+	if n := deliveries(); n != 2 {
+		t.Fatalf("expected 2 deliveries after the second approval, got %d", n)
+	}
+
+	// A change to the execution that doesn't run the workflow again does not notify again.
+	wfe.Status.State = types.WorkflowStateComplete
+	wfe.Status.WorkflowGeneration = 1
+	notify()
+	wfe.Generation = 2
+	notify()
+	if n := deliveries(); n != 3 {
+		t.Fatalf("expected 3 deliveries after completing, got %d", n)
+	}
+}
+
+func blockedStep(name string) *v1.WorkflowStep {
+	return &v1.WorkflowStep{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1.WorkflowStepSpec{
+			WorkflowExecutionName: "we1",
+			WorkflowGeneration:    1,
+		},
+		Status: v1.WorkflowStepStatus{State: types.WorkflowStateBlocked},
+	}
+}
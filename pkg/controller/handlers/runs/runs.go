@@ -53,6 +53,12 @@ func (h *Handler) Resume(req router.Request, _ router.Response) error {
 		return nil
 	}
 
+	if run.Spec.Abort {
+		run.Status.Error = "run was aborted"
+		run.Status.State = gptscript.Error
+		return nil
+	}
+
 	if run.Spec.PreviousRunName != "" {
 		if err := req.Get(&v1.Run{}, run.Namespace, run.Spec.PreviousRunName); apierrors.IsNotFound(err) {
 			run.Status.Error = fmt.Sprintf("run %s not found: %s", run.Spec.PreviousRunName, run.Status.Error)
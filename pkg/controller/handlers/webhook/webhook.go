@@ -1,13 +1,23 @@
 package webhook
 
 import (
+	"slices"
+	"time"
+
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/fields"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	deliveryRetention     = 7 * 24 * time.Hour
+	maxDeliveries         = 100
+	maxRejectedDeliveries = 20
+)
+
 type Handler struct{}
 
 func New() *Handler {
@@ -33,3 +43,82 @@ func (h *Handler) SetSuccessRunTime(req router.Request, _ router.Response) error
 
 	return nil
 }
+
+// PruneDeliveries deletes a delivery once it is older than the retention period or is no longer one of the most recent
+// deliveries of its webhook. Rejected deliveries are kept apart from the others, so that they can't push the others
+// out. Deliveries that started the workflow and have an idempotency key are kept until the idempotency window passes,
+// so that duplicates of them are still detected.
+func (h *Handler) PruneDeliveries(req router.Request, resp router.Response) error {
+	delivery := req.Object.(*v1.WebhookDelivery)
+
+	age := time.Since(delivery.CreationTimestamp.Time)
+	if age > deliveryRetention {
+		return kclient.IgnoreNotFound(req.Delete(delivery))
+	}
+	resp.RetryAfter(deliveryRetention - age)
+
+	var deliveries v1.WebhookDeliveryList
+	if err := req.List(&deliveries, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.webhookName": delivery.Spec.WebhookName}),
+		Namespace:     delivery.Namespace,
+	}); err != nil {
+		return err
+	}
+
+	var others, rejected []v1.WebhookDelivery
+	for _, d := range deliveries.Items {
+		if d.Spec.Result.IsRejected() {
+			rejected = append(rejected, d)
+		} else {
+			others = append(others, d)
+		}
+	}
+
+	newestFirst := func(a, b v1.WebhookDelivery) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	}
+
+	if len(rejected) > maxRejectedDeliveries {
+		slices.SortFunc(rejected, newestFirst)
+		for _, old := range rejected[maxRejectedDeliveries:] {
+			if err := req.Delete(&old); kclient.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+
+	if len(others) <= maxDeliveries {
+		return nil
+	}
+
+	slices.SortFunc(others, newestFirst)
+
+	window := v1.DefaultIdempotencyWindow
+	var webhook v1.Webhook
+	if err := req.Get(&webhook, delivery.Namespace, delivery.Spec.WebhookName); err == nil {
+		if w, err := webhook.GetIdempotencyWindow(); err == nil {
+			window = w
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	for _, old := range others[maxDeliveries:] {
+		if isIdempotencyRecord(old, window) {
+			continue
+		}
+		if err := req.Delete(&old); kclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isIdempotencyRecord returns true if the delivery is what a duplicate delivery with the same idempotency key is
+// detected by.
+func isIdempotencyRecord(delivery v1.WebhookDelivery, window time.Duration) bool {
+	return delivery.Spec.IdempotencyKey != "" && delivery.Spec.ReplayOf == "" &&
+		delivery.Spec.Result != types.WebhookDeliveryResultDuplicate && delivery.Spec.WorkflowExecutionName != "" &&
+		time.Since(delivery.CreationTimestamp.Time) < window
+}
@@ -41,6 +41,12 @@ func populateStepID(seen map[string]struct{}, step types.Step) types.Step {
 	if step.If != nil {
 		step.If = populateIfID(seen, *step.If)
 	}
+	if step.Parallel != nil {
+		step.Parallel = populateParallelID(seen, *step.Parallel)
+	}
+	if step.ForEach != nil {
+		step.ForEach = populateForEachID(seen, *step.ForEach)
+	}
 	return step
 }
 
@@ -60,3 +66,19 @@ func populateIfID(seen map[string]struct{}, ifStep types.If) *types.If {
 	}
 	return &ifStep
 }
+
+func populateParallelID(seen map[string]struct{}, parallel types.Parallel) *types.Parallel {
+	for i, branch := range parallel.Branches {
+		for j, step := range branch.Steps {
+			parallel.Branches[i].Steps[j] = populateStepID(seen, step)
+		}
+	}
+	return &parallel
+}
+
+func populateForEachID(seen map[string]struct{}, forEach types.ForEach) *types.ForEach {
+	for i, step := range forEach.Steps {
+		forEach.Steps[i] = populateStepID(seen, step)
+	}
+	return &forEach
+}
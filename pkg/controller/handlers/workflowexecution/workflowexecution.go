@@ -2,6 +2,7 @@ package workflowexecution
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/obot-platform/nah/pkg/apply"
@@ -25,7 +26,7 @@ func New(invoker *invoke.Invoker) *Handler {
 	}
 }
 
-func (h *Handler) Run(req router.Request, _ router.Response) error {
+func (h *Handler) Run(req router.Request, resp router.Response) error {
 	var (
 		we = req.Object.(*v1.WorkflowExecution)
 	)
@@ -33,6 +34,8 @@ func (h *Handler) Run(req router.Request, _ router.Response) error {
 	if we.Status.State.IsTerminal() {
 		if we.Spec.WorkflowGeneration != we.Status.WorkflowGeneration {
 			we.Status.State = types.WorkflowStatePending
+			we.Status.Error = ""
+			we.Status.StartTime = nil
 			we.Status.EndTime = nil
 		}
 		return nil
@@ -64,6 +67,10 @@ func (h *Handler) Run(req router.Request, _ router.Response) error {
 		}
 	}
 
+	if expired, err := checkTimeout(req, resp, we); err != nil || expired {
+		return err
+	}
+
 	var (
 		steps        []kclient.Object
 		lastStepName = we.Spec.AfterWorkflowStepName
@@ -75,15 +82,20 @@ func (h *Handler) Run(req router.Request, _ router.Response) error {
 		lastStepName = newStep.Name
 	}
 
-	if we.Status.WorkflowManifest.Output != "" {
+	if manifest := we.Status.WorkflowManifest; manifest.Output != "" || len(manifest.OutputSchema) > 0 {
+		output := manifest.Output
+		if output == "" {
+			output = "Give the final result of the workflow."
+		}
 		newStep := workflowstep.NewStep(we.Namespace, we.Name, lastStepName, we.Spec.WorkflowGeneration, types.Step{
-			ID:   "output",
-			Step: we.Status.WorkflowManifest.Output,
+			ID:           "output",
+			Step:         output,
+			OutputSchema: manifest.OutputSchema,
 		})
 		steps = append(steps, newStep)
 	}
 
-	_, output, newState, err := workflowstep.GetStateFromSteps(req.Ctx, req.Client, we.Spec.WorkflowGeneration, steps...)
+	runName, output, newState, err := workflowstep.GetStateFromSteps(req.Ctx, req.Client, we.Spec.WorkflowGeneration, steps...)
 	if err != nil {
 		return err
 	}
@@ -94,8 +106,18 @@ func (h *Handler) Run(req router.Request, _ router.Response) error {
 		return apply.New(req.Client).Apply(req.Ctx, req.Object, steps...)
 	}
 
+	if newState != types.WorkflowStateComplete {
+		we.Status.OutputObject = nil
+	}
+
 	if newState == types.WorkflowStateComplete {
 		we.Status.Output = output
+		we.Status.LastRunName = runName
+		if len(we.Status.WorkflowManifest.OutputSchema) > 0 && we.Status.OutputObject == nil {
+			if we.Status.OutputObject, err = workflowstep.GetOutputObject(req.Ctx, req.Client, we.Namespace, runName); err != nil {
+				return err
+			}
+		}
 	} else if newState == types.WorkflowStateError {
 		we.Status.Error = output
 	}
@@ -165,3 +187,36 @@ func (h *Handler) ReassignThread(req router.Request, _ router.Response) error {
 
 	return nil
 }
+
+// checkTimeout enforces the workflow timeout. Once the timeout has passed, the run in the workflow thread is aborted and
+// the execution is put into an error state, which also stops its steps. Otherwise, the execution is requeued for when
+// the timeout passes.
+func checkTimeout(req router.Request, resp router.Response, we *v1.WorkflowExecution) (expired bool, _ error) {
+	if we.Status.StartTime == nil {
+		we.Status.StartTime = &metav1.Time{Time: time.Now()}
+	}
+
+	remaining, err := workflowstep.WorkflowExecutionTimeRemaining(we)
+	if err != nil {
+		we.Status.State = types.WorkflowStateError
+		we.Status.Error = err.Error()
+		we.Status.WorkflowGeneration = we.Spec.WorkflowGeneration
+		we.Status.EndTime = &metav1.Time{Time: time.Now()}
+		return true, nil
+	} else if remaining == nil {
+		return false, nil
+	} else if *remaining > 0 {
+		resp.RetryAfter(*remaining)
+		return false, nil
+	}
+
+	if err := invoke.AbortThread(req.Ctx, req.Client, we.Namespace, we.Status.ThreadName); err != nil {
+		return false, err
+	}
+
+	we.Status.State = types.WorkflowStateError
+	we.Status.Error = fmt.Sprintf("workflow execution exceeded timeout of %s", we.Status.WorkflowManifest.Timeout)
+	we.Status.WorkflowGeneration = we.Spec.WorkflowGeneration
+	we.Status.EndTime = &metav1.Time{Time: time.Now()}
+	return true, nil
+}
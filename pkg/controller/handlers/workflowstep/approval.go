@@ -0,0 +1,182 @@
+package workflowstep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/randomtoken"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/smtp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	maxNotificationAttempts    = 8
+	initialNotificationBackoff = 30 * time.Second
+	maxNotificationBackoff     = time.Hour
+)
+
+func (h *Handler) RunApproval(req router.Request, resp router.Response) error {
+	step := req.Object.(*v1.WorkflowStep)
+
+	if step.Spec.Step.Approval == nil {
+		return nil
+	}
+
+	if step.Spec.AfterWorkflowStepName == "" {
+		// The approval passes the previous run on to the next step, so there has to be one.
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = "An approval step can not be the first step of a workflow"
+		return nil
+	}
+
+	if step.Status.Approval == nil {
+		token, err := randomtoken.Generate()
+		if err != nil {
+			return err
+		}
+		step.Status.Approval = &v1.StepApproval{
+			Token: token,
+		}
+		step.Status.State = types.WorkflowStateBlocked
+		step.Status.Error = waitingForApproval(step)
+		// The token is saved before the link is sent, so that a link that was sent always works.
+		return req.Client.Status().Update(req.Ctx, step)
+	}
+
+	switch step.Status.Approval.Decision {
+	case v1.ApprovalDecisionApproved:
+		var previousStep v1.WorkflowStep
+		if err := req.Get(&previousStep, step.Namespace, step.Spec.AfterWorkflowStepName); err != nil {
+			return err
+		}
+		step.Status.State = types.WorkflowStateComplete
+		step.Status.LastRunName = previousStep.Status.LastRunName
+		step.Status.Error = ""
+	case v1.ApprovalDecisionRejected:
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = fmt.Sprintf("Rejected by %s", step.Status.Approval.Approver)
+		if step.Status.Approval.Comment != "" {
+			step.Status.Error += ": " + step.Status.Approval.Comment
+		}
+	default:
+		step.Status.State = types.WorkflowStateBlocked
+		step.Status.Error = waitingForApproval(step)
+		if retryAfter := h.notifyApprovers(req.Ctx, step); retryAfter > 0 {
+			resp.RetryAfter(retryAfter)
+		}
+	}
+
+	return nil
+}
+
+func waitingForApproval(step *v1.WorkflowStep) string {
+	if step.Spec.Step.Approval.Message != "" {
+		return "Waiting for approval: " + step.Spec.Step.Approval.Message
+	}
+	return "Waiting for approval"
+}
+
+// notifyApprovers emails the approval link to the approvers of the step that haven't been sent it yet. The link
+// carries the approval token, so it is only sent to them and never stored outside of the step's approval status. An
+// approver that fails doesn't stop the others. If some failed and can be retried, how long to wait before trying them
+// again is returned.
+func (h *Handler) notifyApprovers(ctx context.Context, step *v1.WorkflowStep) time.Duration {
+	approval := step.Status.Approval
+
+	var pending []string
+	for _, approver := range step.Spec.Step.Approval.Approvers {
+		if !slices.Contains(approval.NotifiedApprovers, approver) {
+			pending = append(pending, approver)
+		}
+	}
+	if len(pending) == 0 || approval.NotificationAttempts >= maxNotificationAttempts {
+		return 0
+	}
+	if h.relay == nil || h.relay.From == "" {
+		approval.NotificationError = "no outbound SMTP relay with a From address is configured"
+		return 0
+	}
+	if approval.LastNotificationAt != nil {
+		if wait := time.Until(approval.LastNotificationAt.Add(notificationBackoff(approval.NotificationAttempts))); wait > 0 {
+			return wait
+		}
+	}
+
+	link := fmt.Sprintf("%s/api/workflow-executions/%s/%s/approval?token=%s", h.serverURL, step.Namespace,
+		step.Spec.WorkflowExecutionName, url.QueryEscape(approval.Token))
+
+	body := strings.Builder{}
+	body.WriteString("A workflow is waiting for your approval.\n\n")
+	if step.Spec.Step.Approval.Message != "" {
+		body.WriteString(step.Spec.Step.Approval.Message)
+		body.WriteString("\n\n")
+	}
+	body.WriteString("Open this link to approve or reject it:\n")
+	body.WriteString(link)
+	body.WriteString("\n")
+
+	var (
+		errs      []error
+		retryable bool
+	)
+	for _, approver := range pending {
+		if err := h.notifyApprover(ctx, step, approver, body.String()); err != nil {
+			errs = append(errs, err)
+			retryable = retryable || !smtp.IsPermanentError(err)
+			continue
+		}
+		approval.NotifiedApprovers = append(approval.NotifiedApprovers, approver)
+	}
+
+	now := metav1.Now()
+	approval.NotificationAttempts++
+	approval.LastNotificationAt = &now
+	approval.NotificationError = ""
+	if err := errors.Join(errs...); err != nil {
+		approval.NotificationError = err.Error()
+	}
+
+	if retryable && approval.NotificationAttempts < maxNotificationAttempts {
+		return notificationBackoff(approval.NotificationAttempts)
+	}
+	return 0
+}
+
+func (h *Handler) notifyApprover(ctx context.Context, step *v1.WorkflowStep, approver, body string) error {
+	to, err := mail.ParseAddress(approver)
+	if err != nil {
+		return fmt.Errorf("invalid approver address %q: %w", approver, err)
+	}
+
+	message, err := smtp.Message{
+		From:    h.relay.From,
+		To:      to.String(),
+		Subject: "Approval requested for workflow execution " + step.Spec.WorkflowExecutionName,
+		Body:    body,
+	}.Bytes()
+	if err != nil {
+		return err
+	}
+
+	if err := h.relay.Send(ctx, h.relay.From, []string{to.Address}, message); err != nil {
+		return fmt.Errorf("failed to send approval request to %s: %w", to.Address, err)
+	}
+	return nil
+}
+
+func notificationBackoff(attempts int) time.Duration {
+	d := initialNotificationBackoff
+	for i := 1; i < attempts && d < maxNotificationBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxNotificationBackoff)
+}
@@ -0,0 +1,70 @@
+package workflowstep
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/smtp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRunApprovalSavesTokenBeforeNotifying(t *testing.T) {
+	step := &v1.WorkflowStep{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws1", Namespace: "default"},
+		Spec: v1.WorkflowStepSpec{
+			WorkflowExecutionName: "we1",
+			AfterWorkflowStepName: "ws0",
+			Step: types.Step{
+				ID: "step1",
+				Approval: &types.Approval{
+					Approvers: []string{"not an address", "approver@example.com"},
+				},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(step).WithStatusSubresource(step).Build()
+	// Nothing listens on the relay address, so sending fails with an error that is retried.
+	h := New(nil, "http://localhost:8080", smtp.NewRelay("127.0.0.1:1", "", "", "obot@example.com"))
+
+	if err := h.RunApproval(router.Request{Ctx: context.Background(), Client: c, Object: step}, &testResponse{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var saved v1.WorkflowStep
+	if err := c.Get(context.Background(), kclient.ObjectKeyFromObject(step), &saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved.Status.Approval == nil || saved.Status.Approval.Token == "" {
+		t.Fatal("expected the approval token to be saved")
+	}
+	if saved.Status.Approval.NotificationAttempts != 0 {
+		t.Error("expected no approval link to be sent before the token is saved")
+	}
+
+	resp := &testResponse{}
+	if err := h.RunApproval(router.Request{Ctx: context.Background(), Client: c, Object: &saved}, resp); err != nil {
+		t.Fatal(err)
+	}
+
+	approval := saved.Status.Approval
+	if approval.NotificationAttempts != 1 || len(approval.NotifiedApprovers) != 0 {
+		t.Errorf("expected one failed attempt, got %d attempts and %v notified", approval.NotificationAttempts, approval.NotifiedApprovers)
+	}
+	// The invalid address does not stop the link from being sent to the next approver.
+	if !strings.Contains(approval.NotificationError, "not an address") || !strings.Contains(approval.NotificationError, "approver@example.com") {
+		t.Errorf("expected an error for both approvers, got %q", approval.NotificationError)
+	}
+	if resp.retryAfter <= 0 {
+		t.Error("expected the failed approvers to be retried")
+	}
+	if saved.Status.State != types.WorkflowStateBlocked {
+		t.Errorf("expected state %s, got %s", types.WorkflowStateBlocked, saved.Status.State)
+	}
+}
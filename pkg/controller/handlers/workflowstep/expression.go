@@ -0,0 +1,65 @@
+package workflowstep
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/expression"
+	"github.com/obot-platform/obot/pkg/invoke"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// evaluateExpression evaluates a condition expression in-process instead of asking the model. The expression can reference
+// "input", the workflow input, "output", the output of the step named by afterStepName, and "steps.<name>.output" for steps
+// that declare an output name. Values are decoded if they are JSON.
+// The returned run name is the last run of the previous step, which takes the place of the condition run. If the expression
+// can not be evaluated, the parent step is put into an error state and failed is true.
+func evaluateExpression(ctx context.Context, c kclient.Client, parentStep *v1.WorkflowStep, expr, afterStepName string) (runName string, result, failed bool, err error) {
+	var wfe v1.WorkflowExecution
+	if err := c.Get(ctx, router.Key(parentStep.Namespace, parentStep.Spec.WorkflowExecutionName), &wfe); err != nil {
+		return "", false, false, err
+	}
+
+	var output string
+	if afterStepName != "" {
+		var previousStep v1.WorkflowStep
+		if err := c.Get(ctx, router.Key(parentStep.Namespace, afterStepName), &previousStep); err != nil {
+			return "", false, false, err
+		}
+		if previousStep.Status.LastRunName != "" {
+			runName = previousStep.Status.LastRunName
+			output, err = getRunOutput(ctx, c, parentStep.Namespace, runName)
+			if err != nil {
+				return "", false, false, err
+			}
+		}
+	}
+
+	stepOutputs, err := invoke.StepOutputs(ctx, c, parentStep.Namespace, wfe.Name)
+	if err != nil {
+		return "", false, false, err
+	}
+
+	steps := make(map[string]any, len(stepOutputs))
+	for name, stepOutput := range stepOutputs {
+		steps[name] = map[string]any{
+			"output": expression.Value(stepOutput),
+		}
+	}
+
+	result, err = expression.Evaluate(expr, map[string]any{
+		"input":  expression.Value(wfe.Spec.Input),
+		"output": expression.Value(output),
+		"steps":  steps,
+	})
+	if err != nil {
+		parentStep.Status.Error = fmt.Sprintf("Error evaluating expression: %v", err)
+		parentStep.Status.State = types.WorkflowStateError
+		return "", false, true, nil
+	}
+
+	return runName, result, false, nil
+}
@@ -0,0 +1,251 @@
+package workflowstep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/invoke"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (h *Handler) RunForEach(req router.Request, _ router.Response) (err error) {
+	step := req.Object.(*v1.WorkflowStep)
+
+	if step.Spec.Step.ForEach == nil {
+		return nil
+	}
+
+	var completeResponse bool
+	var objects []kclient.Object
+	defer func() {
+		apply := apply.New(req.Client)
+		if !completeResponse {
+			apply.WithNoPrune()
+		}
+		if applyErr := apply.Apply(req.Ctx, req.Object, objects...); applyErr != nil && err == nil {
+			err = applyErr
+		}
+	}()
+
+	// reset
+	step.Status.Error = ""
+
+	items, err := getForEachItems(req.Ctx, req.Client, step)
+	if err != nil {
+		return err
+	} else if step.Status.State == types.WorkflowStateError {
+		return nil
+	}
+
+	var (
+		maxConcurrency = step.Spec.Step.ForEach.MaxConcurrency
+		outputs        = make([]string, len(items))
+		running        int
+		allComplete    = true
+	)
+
+	// Groups are only created while there is room under the concurrency limit. Once a group is created
+	// it is always redefined on later passes, so the set of groups only grows as earlier ones complete.
+	for i, item := range items {
+		if maxConcurrency > 0 && running >= maxConcurrency {
+			allComplete = false
+			break
+		}
+
+		steps := h.defineForEach(step, i, len(items), item)
+		if len(steps) == 0 {
+			continue
+		}
+		objects = append(objects, steps...)
+
+		runName, errMsg, newState, err := GetStateFromSteps(req.Ctx, req.Client, step.Spec.WorkflowGeneration, steps...)
+		if err != nil {
+			return err
+		}
+
+		if newState.IsBlocked() {
+			step.Status.State = newState
+			step.Status.Error = errMsg
+			return nil
+		}
+
+		if newState != types.WorkflowStateComplete {
+			running++
+			allComplete = false
+			continue
+		}
+
+		outputs[i], err = getRunOutput(req.Ctx, req.Client, step.Namespace, runName)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !allComplete {
+		step.Status.State = types.WorkflowStateRunning
+		return nil
+	}
+
+	preamble := fmt.Sprintf("Each of the following %d items was processed separately.", len(items))
+	if len(items) == 0 {
+		preamble = "There were no items to process, so there are no results."
+	}
+
+	joinStep := h.defineJoin(step, preamble, "Item", outputs)
+	objects = append(objects, joinStep)
+	completeResponse = true
+
+	runName, errMsg, newState, err := GetStateFromSteps(req.Ctx, req.Client, step.Spec.WorkflowGeneration, joinStep)
+	if err != nil {
+		return err
+	}
+
+	if newState.IsBlocked() {
+		step.Status.State = newState
+		step.Status.Error = errMsg
+		return nil
+	}
+
+	step.Status.State = newState
+	step.Status.LastRunName = runName
+	return nil
+}
+
+// getForEachItems resolves the JSON list the step iterates over. If the list can not be found or is not a list, the step
+// is put into an error state and no items are returned.
+func getForEachItems(ctx context.Context, c kclient.Client, step *v1.WorkflowStep) ([]string, error) {
+	var (
+		itemsPath       = step.Spec.Step.ForEach.ItemsOrDefault()
+		source, path, _ = strings.Cut(itemsPath, ".")
+		content         string
+	)
+
+	switch source {
+	case "input":
+		var wfe v1.WorkflowExecution
+		if err := c.Get(ctx, router.Key(step.Namespace, step.Spec.WorkflowExecutionName), &wfe); err != nil {
+			return nil, err
+		}
+		content = wfe.Spec.Input
+	case "output":
+		if step.Spec.AfterWorkflowStepName == "" {
+			step.Status.State = types.WorkflowStateError
+			step.Status.Error = fmt.Sprintf("ForEach items %q refers to the output of a previous step, but there is no previous step", itemsPath)
+			return nil, nil
+		}
+
+		var previousStep v1.WorkflowStep
+		if err := c.Get(ctx, router.Key(step.Namespace, step.Spec.AfterWorkflowStepName), &previousStep); err != nil {
+			return nil, err
+		}
+
+		output, err := getRunOutput(ctx, c, step.Namespace, previousStep.Status.LastRunName)
+		if err != nil {
+			return nil, err
+		}
+		content = output
+	default:
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = fmt.Sprintf("ForEach items %q must start with \"input\" or \"output\"", itemsPath)
+		return nil, nil
+	}
+
+	var data any
+	if err := json.Unmarshal([]byte(trimCodeFence(content)), &data); err != nil {
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = fmt.Sprintf("ForEach items %q is not valid JSON: %v", itemsPath, err)
+		return nil, nil
+	}
+
+	if path != "" {
+		for _, field := range strings.Split(path, ".") {
+			obj, ok := data.(map[string]any)
+			if !ok {
+				step.Status.State = types.WorkflowStateError
+				step.Status.Error = fmt.Sprintf("ForEach items %q can not be resolved, %q is not in an object", itemsPath, field)
+				return nil, nil
+			}
+			data, ok = obj[field]
+			if !ok {
+				step.Status.State = types.WorkflowStateError
+				step.Status.Error = fmt.Sprintf("ForEach items %q can not be resolved, field %q not found", itemsPath, field)
+				return nil, nil
+			}
+		}
+	}
+
+	list, ok := data.([]any)
+	if !ok {
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = fmt.Sprintf("ForEach items %q is not a JSON list", itemsPath)
+		return nil, nil
+	}
+
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+			continue
+		}
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, string(data))
+	}
+
+	return result, nil
+}
+
+// trimCodeFence removes the markdown code fence models commonly wrap JSON in.
+func trimCodeFence(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "```") {
+		return s
+	}
+	s = strings.TrimPrefix(s, "```")
+	s = strings.TrimPrefix(s, "json")
+	s = strings.TrimSuffix(s, "```")
+	return strings.TrimSpace(s)
+}
+
+func (h *Handler) defineForEach(step *v1.WorkflowStep, groupIndex, total int, item string) (result []kclient.Object) {
+	var lastStepName = step.Spec.AfterWorkflowStepName
+
+	for i, loopStep := range step.Spec.Step.ForEach.Steps {
+		loopStep = *loopStep.DeepCopy()
+		loopStep.ID = fmt.Sprintf("%s{index=%d}", loopStep.ID, groupIndex)
+		if i == 0 {
+			withItem(&loopStep, groupIndex, total, item)
+		}
+		newStep := NewStep(step.Namespace, step.Spec.WorkflowExecutionName, lastStepName, step.Spec.WorkflowGeneration, loopStep)
+		result = append(result, newStep)
+		lastStepName = newStep.Name
+	}
+
+	return result
+}
+
+// withItem passes the current item to the first step of a group. Templates receive it as the "item" argument and
+// prompts are prefixed with it. Later steps in the group see the item in the chat history. The item is data, so any
+// variable references in it are escaped.
+func withItem(step *types.Step, index, total int, item string) {
+	item = invoke.EscapeVariables(item)
+	if step.Template != nil {
+		args := maps.Clone(step.Template.Args)
+		if args == nil {
+			args = map[string]string{}
+		}
+		args["item"] = item
+		step.Template.Args = args
+	} else if step.Step != "" {
+		step.Step = fmt.Sprintf("Current item (%d of %d):\n%s\n\n%s", index+1, total, item, step.Step)
+	}
+}
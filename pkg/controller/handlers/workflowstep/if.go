@@ -32,25 +32,42 @@ func (h *Handler) RunIf(req router.Request, _ router.Response) (err error) {
 		}
 	}()
 
-	conditionStep := h.defineCondition(step, nil, 0)
-	objects = append(objects, conditionStep)
+	var (
+		conditionRunName string
+		conditionResult  bool
+		afterStepName    string
+	)
 
-	if _, errorMsg, state, err := GetStateFromSteps(req.Ctx, req.Client, step.Spec.WorkflowGeneration, conditionStep); err != nil {
-		return err
-	} else if state.IsBlocked() {
-		step.Status.State = state
-		step.Status.Error = errorMsg
-		return nil
-	}
+	if step.Spec.Step.If.Expression != "" {
+		var failed bool
+		conditionRunName, conditionResult, failed, err = evaluateExpression(req.Ctx, req.Client, step, step.Spec.Step.If.Expression, step.Spec.AfterWorkflowStepName)
+		if err != nil || failed {
+			return err
+		}
+		afterStepName = step.Spec.AfterWorkflowStepName
+	} else {
+		conditionStep := h.defineCondition(step, nil, 0)
+		objects = append(objects, conditionStep)
+
+		if _, errorMsg, state, err := GetStateFromSteps(req.Ctx, req.Client, step.Spec.WorkflowGeneration, conditionStep); err != nil {
+			return err
+		} else if state.IsBlocked() {
+			step.Status.State = state
+			step.Status.Error = errorMsg
+			return nil
+		}
 
-	conditionRunName, conditionResult, wait, err := getConditionResult(req.Ctx, req.Client, step, conditionStep)
-	if err != nil {
-		return err
-	} else if wait {
-		return nil
+		var wait bool
+		conditionRunName, conditionResult, wait, err = getConditionResult(req.Ctx, req.Client, step, conditionStep)
+		if err != nil {
+			return err
+		} else if wait {
+			return nil
+		}
+		afterStepName = conditionStep.Name
 	}
 
-	steps, err := h.defineIfSteps(step, conditionStep, conditionResult)
+	steps, err := h.defineIfSteps(step, afterStepName, conditionResult)
 	if err != nil {
 		return err
 	}
@@ -58,6 +75,12 @@ func (h *Handler) RunIf(req router.Request, _ router.Response) (err error) {
 	completeResponse = true
 
 	if len(steps) == 0 {
+		if conditionRunName == "" {
+			// Only possible with an expression on the first step of a workflow, there is no output to pass on.
+			step.Status.State = types.WorkflowStateError
+			step.Status.Error = "If step has no steps to run and no previous step output to pass on"
+			return nil
+		}
 		step.Status.State = types.WorkflowStateComplete
 		step.Status.LastRunName = conditionRunName
 		return nil
@@ -160,7 +183,7 @@ func (h *Handler) defineCondition(step, afterStep *v1.WorkflowStep, iteration in
 	return newStep
 }
 
-func (h *Handler) defineIfSteps(step *v1.WorkflowStep, conditionStep *v1.WorkflowStep, conditionResult bool) (result []kclient.Object, _ error) {
+func (h *Handler) defineIfSteps(step *v1.WorkflowStep, afterStepName string, conditionResult bool) (result []kclient.Object, _ error) {
 	var steps []types.Step
 	if conditionResult {
 		steps = step.Spec.Step.If.Steps
@@ -168,13 +191,9 @@ func (h *Handler) defineIfSteps(step *v1.WorkflowStep, conditionStep *v1.Workflo
 		steps = step.Spec.Step.If.Else
 	}
 
-	var lastStepName string
-	for i, ifStep := range steps {
-		afterStepName := conditionStep.Name
-		if i > 0 {
-			afterStepName = lastStepName
-		}
-		newStep := NewStep(step.Namespace, step.Spec.WorkflowExecutionName, afterStepName, step.Spec.WorkflowGeneration, ifStep)
+	var lastStepName = afterStepName
+	for _, ifStep := range steps {
+		newStep := NewStep(step.Namespace, step.Spec.WorkflowExecutionName, lastStepName, step.Spec.WorkflowGeneration, ifStep)
 		result = append(result, newStep)
 		lastStepName = newStep.Name
 	}
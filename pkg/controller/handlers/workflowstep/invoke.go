@@ -1,6 +1,8 @@
 package workflowstep
 
 import (
+	"errors"
+
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/nah/pkg/uncached"
@@ -10,15 +12,16 @@ import (
 	"k8s.io/client-go/util/retry"
 )
 
-func (h *Handler) RunInvoke(req router.Request, _ router.Response) error {
+func (h *Handler) RunInvoke(req router.Request, resp router.Response) error {
 	var (
 		ctx         = req.Ctx
 		client      = req.Client
 		step        = req.Object.(*v1.WorkflowStep)
 		lastRunName string
+		approval    *v1.StepApproval
 	)
 
-	if step.Spec.Step.If != nil || step.Spec.Step.While != nil {
+	if step.Spec.Step.If != nil || step.Spec.Step.While != nil || step.Spec.Step.Parallel != nil || step.Spec.Step.ForEach != nil || step.Spec.Step.Approval != nil {
 		return nil
 	}
 
@@ -28,12 +31,15 @@ func (h *Handler) RunInvoke(req router.Request, _ router.Response) error {
 			return err
 		}
 		lastRunName = previousStep.Status.LastRunName
+		approval = previousStep.Status.Approval
 	}
 
 	var run v1.Run
-	if len(step.Status.RunNames) == 0 {
+	if runNames := step.Status.AttemptRunNames(); len(runNames) == 0 {
 		invokeResp, err := h.invoker.Step(ctx, req.Client, step, invoke.StepOptions{
 			PreviousRunName: lastRunName,
+			Approval:        approval,
+			Timeout:         runTimeout(step),
 		})
 		if err != nil {
 			return err
@@ -45,7 +51,7 @@ func (h *Handler) RunInvoke(req router.Request, _ router.Response) error {
 				return err
 			}
 			step.Status.ThreadName = invokeResp.Thread.Name
-			step.Status.RunNames = []string{invokeResp.Run.Name}
+			step.Status.RunNames = append(step.Status.RunNames, invokeResp.Run.Name)
 			return client.Status().Update(ctx, step)
 		})
 		if err != nil {
@@ -54,7 +60,9 @@ func (h *Handler) RunInvoke(req router.Request, _ router.Response) error {
 
 		run = *invokeResp.Run
 	} else {
-		if err := req.Get(&run, step.Namespace, step.Status.RunNames[0]); err != nil {
+		// Later runs of an attempt continue the step after a subflow or correct output that did not match the
+		// schema, so the latest one decides the state of the step.
+		if err := req.Get(&run, step.Namespace, runNames[len(runNames)-1]); err != nil {
 			return err
 		}
 	}
@@ -64,17 +72,80 @@ func (h *Handler) RunInvoke(req router.Request, _ router.Response) error {
 		if run.Status.SubCall != nil {
 			step.Status.State = types.WorkflowStateSubCall
 			step.Status.SubCalls = []v1.SubCall{*run.Status.SubCall}
-		} else {
-			step.Status.State = types.WorkflowStateComplete
-			step.Status.LastRunName = step.Status.RunNames[0]
-			step.Status.SubCalls = nil
+			step.Status.Error = ""
+			return nil
+		}
+
+		if len(step.Spec.Step.OutputSchema) > 0 {
+			if done, err := h.checkOutputSchema(req, step, &run); err != nil || !done {
+				return err
+			}
 		}
+
+		step.Status.State = types.WorkflowStateComplete
+		step.Status.LastRunName = run.Name
+		step.Status.SubCalls = nil
 		step.Status.Error = ""
 	case gptscript.Error:
-		step.Status.State = types.WorkflowStateError
-		step.Status.LastRunName = step.Status.RunNames[0]
-		step.Status.Error = run.Status.Error
+		if retrying, err := checkRetry(step, run.Status.Error, run.Status.EndTime.Time, resp); err != nil {
+			step.Status.State = types.WorkflowStateError
+			step.Status.LastRunName = run.Name
+			step.Status.Error = err.Error()
+		} else if !retrying {
+			step.Status.State = types.WorkflowStateError
+			step.Status.LastRunName = run.Name
+			step.Status.Error = run.Status.Error
+		}
 	}
 
 	return nil
 }
+
+// checkOutputSchema validates the output of a finished run against the step's output schema. If it does not match, the
+// model is asked to correct it in a new run until maxOutputSchemaAttempts is reached, after which the step fails.
+// done is true if the output matched.
+func (h *Handler) checkOutputSchema(req router.Request, step *v1.WorkflowStep, run *v1.Run) (done bool, _ error) {
+	output, err := getRunOutput(req.Ctx, req.Client, step.Namespace, run.Name)
+	if err != nil {
+		return false, err
+	}
+
+	_, validateErr := validateOutput(step.Spec.Step.OutputSchema, output)
+	if validateErr == nil {
+		return true, nil
+	}
+
+	if errors.Is(validateErr, errInvalidOutputSchema) || step.Status.OutputSchemaCorrections+1 >= maxOutputSchemaAttempts {
+		step.Status.State = types.WorkflowStateError
+		step.Status.LastRunName = run.Name
+		step.Status.Error = validateErr.Error()
+		return false, nil
+	}
+
+	correction := outputSchemaCorrection(validateErr)
+	invokeResp, err := h.invoker.Step(req.Ctx, req.Client, step, invoke.StepOptions{
+		PreviousRunName: run.Name,
+		Continue:        &correction,
+		Timeout:         runTimeout(step),
+	})
+	if err != nil {
+		return false, err
+	}
+	defer invokeResp.Close()
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := req.Client.Get(req.Ctx, router.Key(step.Namespace, step.Name), uncached.Get(step)); err != nil {
+			return err
+		}
+		step.Status.RunNames = append(step.Status.RunNames, invokeResp.Run.Name)
+		step.Status.OutputSchemaCorrections++
+		return req.Client.Status().Update(req.Ctx, step)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	step.Status.State = types.WorkflowStateRunning
+	step.Status.Error = validateErr.Error()
+	return false, nil
+}
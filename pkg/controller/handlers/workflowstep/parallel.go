@@ -0,0 +1,127 @@
+package workflowstep
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/invoke"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (h *Handler) RunParallel(req router.Request, _ router.Response) (err error) {
+	step := req.Object.(*v1.WorkflowStep)
+
+	if step.Spec.Step.Parallel == nil {
+		return nil
+	}
+
+	var completeResponse bool
+	var objects []kclient.Object
+	defer func() {
+		apply := apply.New(req.Client)
+		if !completeResponse {
+			apply.WithNoPrune()
+		}
+		if applyErr := apply.Apply(req.Ctx, req.Object, objects...); applyErr != nil && err == nil {
+			err = applyErr
+		}
+	}()
+
+	// reset
+	step.Status.Error = ""
+
+	var (
+		outputs     = make([]string, len(step.Spec.Step.Parallel.Branches))
+		allComplete = true
+	)
+
+	// All branches are created at once so that they run concurrently. Each branch starts from the
+	// same previous step, so each one sees the same chat history.
+	for i := range step.Spec.Step.Parallel.Branches {
+		steps := h.defineBranch(step, i)
+		if len(steps) == 0 {
+			continue
+		}
+		objects = append(objects, steps...)
+
+		runName, errMsg, newState, err := GetStateFromSteps(req.Ctx, req.Client, step.Spec.WorkflowGeneration, steps...)
+		if err != nil {
+			return err
+		}
+
+		if newState.IsBlocked() {
+			step.Status.State = newState
+			step.Status.Error = errMsg
+			return nil
+		}
+
+		if newState != types.WorkflowStateComplete {
+			allComplete = false
+			continue
+		}
+
+		outputs[i], err = getRunOutput(req.Ctx, req.Client, step.Namespace, runName)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !allComplete {
+		step.Status.State = types.WorkflowStateRunning
+		return nil
+	}
+
+	joinStep := h.defineJoin(step, "The following branches were run in parallel.", "Branch", outputs)
+	objects = append(objects, joinStep)
+	completeResponse = true
+
+	runName, errMsg, newState, err := GetStateFromSteps(req.Ctx, req.Client, step.Spec.WorkflowGeneration, joinStep)
+	if err != nil {
+		return err
+	}
+
+	if newState.IsBlocked() {
+		step.Status.State = newState
+		step.Status.Error = errMsg
+		return nil
+	}
+
+	step.Status.State = newState
+	step.Status.LastRunName = runName
+	return nil
+}
+
+func (h *Handler) defineBranch(step *v1.WorkflowStep, branchIndex int) (result []kclient.Object) {
+	var lastStepName = step.Spec.AfterWorkflowStepName
+	for _, branchStep := range step.Spec.Step.Parallel.Branches[branchIndex].Steps {
+		newStep := NewStep(step.Namespace, step.Spec.WorkflowExecutionName, lastStepName, step.Spec.WorkflowGeneration, branchStep)
+		result = append(result, newStep)
+		lastStepName = newStep.Name
+	}
+	return result
+}
+
+// defineJoin creates the step that combines the outputs of concurrently run groups of steps into one
+// result. This step follows the same previous step as the groups so that the next step sees a single
+// linear history.
+func (h *Handler) defineJoin(step *v1.WorkflowStep, preamble, label string, outputs []string) *v1.WorkflowStep {
+	return NewStep(step.Namespace, step.Spec.WorkflowExecutionName, step.Spec.AfterWorkflowStepName, step.Spec.WorkflowGeneration, types.Step{
+		ID:           step.Spec.Step.ID + "{join}",
+		Step:         toJoinPrompt(preamble, label, outputs),
+		OutputSchema: step.Spec.Step.OutputSchema,
+	})
+}
+
+func toJoinPrompt(preamble, label string, outputs []string) string {
+	prompt := strings.Builder{}
+	prompt.WriteString(preamble)
+	prompt.WriteString(" Combine their results into a single response, keeping the details from each one:\n")
+	for i, output := range outputs {
+		prompt.WriteString(fmt.Sprintf("\n%s %d result:\n%s\n", label, i+1, invoke.EscapeVariables(output)))
+	}
+	return prompt.String()
+}
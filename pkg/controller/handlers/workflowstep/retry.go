@@ -0,0 +1,109 @@
+package workflowstep
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+)
+
+const defaultRetryBackoff = 10 * time.Second
+
+var (
+	rateLimitErrorRegexp = regexp.MustCompile(`\b429\b`)
+	serverErrorRegexp    = regexp.MustCompile(`\b(500|502|503|504)\b`)
+	toolErrorRegexp      = regexp.MustCompile(`(?i)\b(while running tool|failed to run tool|failed to find tool|while parsing command)\b`)
+)
+
+// checkRetry decides whether a failed attempt is retried according to the step's retry policy. failure is the error of
+// the attempt and failedAt is when it failed, which the backoff is measured from. Once the backoff has passed, the runs
+// of the failed attempt are skipped so that the next pass starts a new run.
+func checkRetry(step *v1.WorkflowStep, failure string, failedAt time.Time, resp router.Response) (bool, error) {
+	retry := step.Spec.Step.Retry
+	if retry == nil || step.Status.RetryCount+1 >= retry.MaxAttempts || !isRetryable(retry, failure) {
+		return false, nil
+	}
+
+	delay, err := retryDelay(retry, step.Status.RetryCount)
+	if err != nil {
+		return false, err
+	}
+
+	attempt := step.Status.RetryCount + 1
+	if remaining := delay - time.Since(failedAt); remaining > 0 {
+		step.Status.State = types.WorkflowStateRunning
+		step.Status.Error = fmt.Sprintf("attempt %d of %d failed, retrying in %s: %s", attempt, retry.MaxAttempts, remaining.Round(time.Second), failure)
+		resp.RetryAfter(remaining)
+		return true, nil
+	}
+
+	step.Status.RetryCount++
+	step.Status.AttemptRunOffset = len(step.Status.RunNames)
+	step.Status.OutputSchemaCorrections = 0
+	step.Status.SubCalls = nil
+	step.Status.State = types.WorkflowStateRunning
+	step.Status.Error = fmt.Sprintf("attempt %d of %d failed, retrying: %s", attempt, retry.MaxAttempts, failure)
+	return true, nil
+}
+
+func retryDelay(retry *types.Retry, retryCount int) (time.Duration, error) {
+	var (
+		delay      = defaultRetryBackoff
+		maxBackoff time.Duration
+		err        error
+	)
+
+	if retry.Backoff != "" {
+		if delay, err = time.ParseDuration(retry.Backoff); err != nil {
+			return 0, fmt.Errorf("invalid retry backoff %q: %w", retry.Backoff, err)
+		}
+	}
+	if retry.MaxBackoff != "" {
+		if maxBackoff, err = time.ParseDuration(retry.MaxBackoff); err != nil {
+			return 0, fmt.Errorf("invalid retry maxBackoff %q: %w", retry.MaxBackoff, err)
+		}
+	}
+
+	for range retryCount {
+		delay *= 2
+		if maxBackoff > 0 && delay >= maxBackoff {
+			break
+		}
+	}
+
+	if maxBackoff > 0 && delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay, nil
+}
+
+func isRetryable(retry *types.Retry, errMsg string) bool {
+	if len(retry.On) == 0 {
+		return true
+	}
+	class := toErrorClass(errMsg)
+	return class != "" && slices.Contains(retry.On, class)
+}
+
+func toErrorClass(errMsg string) types.RetryErrorClass {
+	lower := strings.ToLower(errMsg)
+	switch {
+	case rateLimitErrorRegexp.MatchString(errMsg) || strings.Contains(lower, "rate limit") || strings.Contains(lower, "too many requests"):
+		return types.RetryErrorClassRateLimit
+	case serverErrorRegexp.MatchString(errMsg) ||
+		strings.Contains(lower, "internal server error") ||
+		strings.Contains(lower, "bad gateway") ||
+		strings.Contains(lower, "service unavailable") ||
+		strings.Contains(lower, "gateway timeout") ||
+		strings.Contains(lower, "overloaded"):
+		return types.RetryErrorClassServerError
+	case toolErrorRegexp.MatchString(errMsg):
+		return types.RetryErrorClassToolError
+	}
+	return ""
+}
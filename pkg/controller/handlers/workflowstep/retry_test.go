@@ -0,0 +1,117 @@
+package workflowstep
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testResponse struct {
+	retryAfter time.Duration
+}
+
+func (t *testResponse) Attributes() map[string]any {
+	return map[string]any{}
+}
+
+func (t *testResponse) RetryAfter(delay time.Duration) {
+	t.retryAfter = delay
+}
+
+func TestToErrorClass(t *testing.T) {
+	tests := map[string]types.RetryErrorClass{
+		"error, status code: 429, message: Too Many Requests":              types.RetryErrorClassRateLimit,
+		"failed calling model for completion: 503 Service Unavailable":     types.RetryErrorClassServerError,
+		"ERROR: got (exit status 1) while running tool, OUTPUT: not found": types.RetryErrorClassToolError,
+		"order 14290 was not found":                                        "",
+		"the toolbox is empty":                                             "",
+	}
+	for errMsg, expected := range tests {
+		if class := toErrorClass(errMsg); class != expected {
+			t.Errorf("%q: expected class %q, got %q", errMsg, expected, class)
+		}
+	}
+}
+
+func TestCheckRetryCountsAttempts(t *testing.T) {
+	step := &v1.WorkflowStep{
+		Spec: v1.WorkflowStepSpec{
+			Step: types.Step{
+				Retry: &types.Retry{MaxAttempts: 3, Backoff: "1s"},
+			},
+		},
+		Status: v1.WorkflowStepStatus{
+			// The first attempt had a run that corrected its output.
+			RunNames: []string{"run1", "run2"},
+		},
+	}
+	run := &v1.Run{
+		Status: v1.RunStatus{
+			Error:   "failed",
+			EndTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+		},
+	}
+
+	retrying, err := checkRetry(step, run.Status.Error, run.Status.EndTime.Time, &testResponse{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !retrying {
+		t.Fatal("expected the step to be retried")
+	}
+	if step.Status.RetryCount != 1 || step.Status.AttemptRunOffset != 2 || len(step.Status.AttemptRunNames()) != 0 {
+		t.Errorf("unexpected status, retry count %d, attempt run offset %d", step.Status.RetryCount, step.Status.AttemptRunOffset)
+	}
+
+	step.Status.RunNames = append(step.Status.RunNames, "run3")
+	if retrying, err = checkRetry(step, run.Status.Error, run.Status.EndTime.Time, &testResponse{}); err != nil {
+		t.Fatal(err)
+	} else if !retrying || step.Status.RetryCount != 2 {
+		t.Errorf("expected the second attempt to be retried, retry count %d", step.Status.RetryCount)
+	}
+
+	step.Status.RunNames = append(step.Status.RunNames, "run4")
+	if retrying, err = checkRetry(step, run.Status.Error, run.Status.EndTime.Time, &testResponse{}); err != nil {
+		t.Fatal(err)
+	} else if retrying {
+		t.Error("expected the last attempt to not be retried")
+	}
+}
+
+func TestFailedSubflowRetries(t *testing.T) {
+	step := &v1.WorkflowStep{
+		Spec: v1.WorkflowStepSpec{
+			Step: types.Step{
+				Retry: &types.Retry{MaxAttempts: 2, Backoff: "1m"},
+			},
+		},
+		Status: v1.WorkflowStepStatus{
+			State:    types.WorkflowStateSubCall,
+			RunNames: []string{"run1"},
+			SubCalls: []v1.SubCall{{Workflow: "other"}},
+		},
+	}
+
+	resp := &testResponse{}
+	failedSubflow(step, "failed", time.Now(), resp)
+	if step.Status.State != types.WorkflowStateSubCall || step.Status.RetryCount != 0 || resp.retryAfter <= 0 {
+		t.Errorf("expected the step to wait for the backoff, got state %s and retry count %d", step.Status.State, step.Status.RetryCount)
+	}
+
+	failedSubflow(step, "failed", time.Now().Add(-2*time.Minute), &testResponse{})
+	if step.Status.State != types.WorkflowStateRunning || step.Status.RetryCount != 1 || len(step.Status.AttemptRunNames()) != 0 {
+		t.Errorf("expected the step to be retried, got state %s and retry count %d", step.Status.State, step.Status.RetryCount)
+	}
+	if name := subflowExecutionName(step, 0, v1.SubCall{Workflow: "other"}); !strings.Contains(name, "r1-0-other") {
+		t.Errorf("expected the retried attempt to get a new subflow execution, got %s", name)
+	}
+
+	failedSubflow(step, "failed", time.Now().Add(-2*time.Minute), &testResponse{})
+	if step.Status.State != types.WorkflowStateError || step.Status.Error != "failed" {
+		t.Errorf("expected the last attempt to fail the step, got state %s", step.Status.State)
+	}
+}
@@ -0,0 +1,63 @@
+package workflowstep
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxOutputSchemaAttempts is the number of times a step is prompted before output that does not match its schema is an error.
+const maxOutputSchemaAttempts = 3
+
+var errInvalidOutputSchema = errors.New("invalid output schema")
+
+// validateOutput parses output as JSON and checks it against schema. The compacted JSON is returned if it matches,
+// otherwise the error describes every mismatch so it can be sent back to the model.
+func validateOutput(schema json.RawMessage, output string) (json.RawMessage, error) {
+	data := []byte(trimCodeFence(output))
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, data); err != nil {
+		return nil, fmt.Errorf("output is not valid JSON: %w", err)
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schema), gojsonschema.NewBytesLoader(compact.Bytes()))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidOutputSchema, err)
+	}
+
+	if !result.Valid() {
+		errs := make([]string, 0, len(result.Errors()))
+		for _, resultErr := range result.Errors() {
+			errs = append(errs, resultErr.String())
+		}
+		return nil, fmt.Errorf("output does not match the schema: %s", strings.Join(errs, "; "))
+	}
+
+	return compact.Bytes(), nil
+}
+
+// GetOutputObject returns the output of a run as compacted JSON. It is meant for runs of steps that have already
+// validated their output against a schema.
+func GetOutputObject(ctx context.Context, c kclient.Client, namespace, runName string) (json.RawMessage, error) {
+	output, err := getRunOutput(ctx, c, namespace, runName)
+	if err != nil {
+		return nil, err
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, []byte(trimCodeFence(output))); err != nil {
+		return nil, err
+	}
+	return compact.Bytes(), nil
+}
+
+func outputSchemaCorrection(err error) string {
+	return fmt.Sprintf("Your last response is invalid, %v.\nRespond again with only the corrected JSON and no other text.", err)
+}
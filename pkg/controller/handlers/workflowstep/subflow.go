@@ -3,6 +3,7 @@ package workflowstep
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/nah/pkg/apply"
@@ -15,9 +16,10 @@ import (
 	"github.com/obot-platform/obot/pkg/system"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (h *Handler) RunSubflow(req router.Request, _ router.Response) error {
+func (h *Handler) RunSubflow(req router.Request, resp router.Response) error {
 	step := req.Object.(*v1.WorkflowStep)
 
 	if step.Status.State != types.WorkflowStateSubCall {
@@ -25,7 +27,8 @@ func (h *Handler) RunSubflow(req router.Request, _ router.Response) error {
 	}
 
 	// The runs maybe zero on a rerun, reset state to pending
-	if len(step.Status.RunNames) == 0 {
+	runNames := step.Status.AttemptRunNames()
+	if len(runNames) == 0 {
 		step.Status.State = types.WorkflowStatePending
 		return nil
 	}
@@ -36,7 +39,7 @@ func (h *Handler) RunSubflow(req router.Request, _ router.Response) error {
 	}
 
 	for i, subCall := range step.Status.SubCalls {
-		if len(step.Status.RunNames) > i+1 {
+		if len(runNames) > i+1 {
 			continue
 		}
 
@@ -47,13 +50,13 @@ func (h *Handler) RunSubflow(req router.Request, _ router.Response) error {
 
 		wfe := &v1.WorkflowExecution{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      name.SafeConcatName(system.WorkflowExecutionPrefix+strings.TrimPrefix(step.Name, system.WorkflowStepPrefix), fmt.Sprintf("%d-%s", i, subCall.Workflow)),
+				Name:      subflowExecutionName(step, i, subCall),
 				Namespace: step.Namespace,
 			},
 			Spec: v1.WorkflowExecutionSpec{
 				Input:                 subCall.Input,
 				ParentThreadName:      step.Status.ThreadName,
-				ParentRunName:         step.Status.RunNames[i],
+				ParentRunName:         runNames[i],
 				WorkflowName:          wf.Name,
 				AfterWorkflowStepName: step.Spec.AfterWorkflowStepName,
 				WorkspaceName:         wf.Status.WorkspaceName,
@@ -65,35 +68,40 @@ func (h *Handler) RunSubflow(req router.Request, _ router.Response) error {
 			return err
 		}
 
-		out, isErr, done, err := h.getSubflowOutput(req, wfe)
+		subflow, done, err := h.getSubflow(req, wfe)
 		if err != nil {
 			return err
 		}
 
-		if isErr {
-			step.Status.State = types.WorkflowStateError
-			step.Status.Error = out
-			return req.Client.Status().Update(req.Ctx, step)
-		}
-
 		if !done {
 			return nil
 		}
 
-		resp, err := h.invoker.Step(req.Ctx, req.Client, step, invoke.StepOptions{
-			PreviousRunName: step.Status.RunNames[i],
+		if subflow.Status.State == types.WorkflowStateError {
+			failedAt := time.Now()
+			if subflow.Status.EndTime != nil {
+				failedAt = subflow.Status.EndTime.Time
+			}
+			failedSubflow(step, subflow.Status.Error, failedAt, resp)
+			return req.Client.Status().Update(req.Ctx, step)
+		}
+
+		out := subflow.Status.Output
+		invokeResp, err := h.invoker.Step(req.Ctx, req.Client, step, invoke.StepOptions{
+			PreviousRunName: runNames[i],
 			Continue:        &out,
+			Timeout:         runTimeout(step),
 		})
 		if err != nil {
 			return err
 		}
-		defer resp.Close()
+		defer invokeResp.Close()
 
-		step.Status.RunNames = append(step.Status.RunNames, resp.Run.Name)
+		step.Status.RunNames = append(step.Status.RunNames, invokeResp.Run.Name)
 		return req.Client.Status().Update(req.Ctx, step)
 	}
 
-	nextRunName := step.Status.RunNames[len(step.Status.SubCalls)]
+	nextRunName := runNames[len(step.Status.SubCalls)]
 
 	var run v1.Run
 	if err := req.Get(&run, step.Namespace, nextRunName); err != nil {
@@ -110,31 +118,71 @@ func (h *Handler) RunSubflow(req router.Request, _ router.Response) error {
 			step.Status.Error = ""
 		}
 	case gptscript.Error:
-		step.Status.State = types.WorkflowStateError
 		step.Status.LastRunName = nextRunName
+		failedSubflow(step, run.Status.Error, run.Status.EndTime.Time, resp)
 	}
 
 	return nil
 }
 
-func (h *Handler) getSubflowOutput(req router.Request, wfe *v1.WorkflowExecution) (string, bool, bool, error) {
-	var (
-		check v1.WorkflowExecution
-	)
+// failedSubflow retries the step according to its retry policy after the subflow or the run continuing after it failed,
+// or fails the step.
+func failedSubflow(step *v1.WorkflowStep, failure string, failedAt time.Time, resp router.Response) {
+	retryCount := step.Status.RetryCount
+	if retrying, err := checkRetry(step, failure, failedAt, resp); err != nil {
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = err.Error()
+	} else if !retrying {
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = failure
+	} else if step.Status.RetryCount == retryCount {
+		// The step is waiting out the backoff, so it keeps checking the failed subflow until then.
+		step.Status.State = types.WorkflowStateSubCall
+	}
+}
 
-	if err := req.Get(&check, wfe.Namespace, wfe.Name); apierrors.IsNotFound(err) {
-		return "", false, false, nil
-	} else if err != nil {
-		return "", false, false, err
+// subflowExecutionName returns the name of the workflow execution of a subflow call of the step. A retried attempt of
+// the step gets new executions, so that it doesn't pick up the result of the failed attempt.
+func subflowExecutionName(step *v1.WorkflowStep, i int, subCall v1.SubCall) string {
+	suffix := fmt.Sprintf("%d-%s", i, subCall.Workflow)
+	if step.Status.RetryCount > 0 {
+		suffix = fmt.Sprintf("r%d-%s", step.Status.RetryCount, suffix)
 	}
+	return name.SafeConcatName(system.WorkflowExecutionPrefix+strings.TrimPrefix(step.Name, system.WorkflowStepPrefix), suffix)
+}
 
-	if check.Status.State == types.WorkflowStateError && check.Status.WorkflowGeneration == wfe.Spec.WorkflowGeneration {
-		return check.Status.Error, true, true, nil
+// stopSubflows aborts the runs of the subflow executions started by the step and deletes the executions, so that they
+// don't go on to their next steps.
+func stopSubflows(req router.Request, step *v1.WorkflowStep) error {
+	for i, subCall := range step.Status.SubCalls {
+		var wfe v1.WorkflowExecution
+		if err := req.Get(&wfe, step.Namespace, subflowExecutionName(step, i, subCall)); apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if wfe.Status.ThreadName != "" {
+			if err := invoke.AbortThread(req.Ctx, req.Client, wfe.Namespace, wfe.Status.ThreadName); err != nil {
+				return err
+			}
+		}
+		if err := req.Delete(&wfe); kclient.IgnoreNotFound(err) != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	if check.Status.State != types.WorkflowStateComplete || check.Status.WorkflowGeneration != wfe.Spec.WorkflowGeneration {
-		return "", false, false, nil
+// getSubflow returns the workflow execution of a subflow call as it is in storage. done is true if it finished the
+// generation it was started for.
+func (h *Handler) getSubflow(req router.Request, wfe *v1.WorkflowExecution) (_ *v1.WorkflowExecution, done bool, _ error) {
+	var check v1.WorkflowExecution
+	if err := req.Get(&check, wfe.Namespace, wfe.Name); apierrors.IsNotFound(err) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
 	}
 
-	return check.Status.Output, false, true, nil
+	return &check, check.Status.State.IsTerminal() && check.Status.WorkflowGeneration == wfe.Spec.WorkflowGeneration, nil
 }
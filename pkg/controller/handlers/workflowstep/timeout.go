@@ -0,0 +1,171 @@
+package workflowstep
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/invoke"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// checkTimeout enforces the step timeout. The first time a step proceeds its start time is recorded. Once the timeout has
+// passed, the runs of the step and its nested steps and any subflows the step called are stopped, and the step is put
+// into an error state. Otherwise, the step is requeued for when the timeout passes. expired is true if the step must not proceed.
+func checkTimeout(req router.Request, resp router.Response, step *v1.WorkflowStep) (expired bool, _ error) {
+	if step.Status.StartTime == nil {
+		step.Status.StartTime = &metav1.Time{Time: time.Now()}
+	}
+
+	remaining, err := stepTimeRemaining(step)
+	if err != nil {
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = err.Error()
+		return true, nil
+	} else if remaining == nil {
+		return false, nil
+	}
+
+	if *remaining > 0 {
+		resp.RetryAfter(*remaining)
+		return false, nil
+	}
+
+	var wfe v1.WorkflowExecution
+	if err := req.Get(&wfe, step.Namespace, step.Spec.WorkflowExecutionName); err != nil {
+		return false, err
+	}
+
+	if err := abortStepRuns(req, step, &wfe); err != nil {
+		return false, err
+	}
+
+	// Subflows run in threads of their own, so they are stopped separately.
+	if err := stopSubflows(req, step); err != nil {
+		return false, err
+	}
+
+	step.Status.State = types.WorkflowStateError
+	step.Status.Error = fmt.Sprintf("step exceeded timeout of %s", step.Spec.Step.Timeout)
+	return true, nil
+}
+
+// abortStepRuns aborts the runs of the step and of the steps nested in it. Other steps share the thread, such as the
+// other branches of a parallel step, so only these runs are aborted instead of the thread.
+func abortStepRuns(req router.Request, step *v1.WorkflowStep, wfe *v1.WorkflowExecution) error {
+	if wfe.Status.ThreadName == "" {
+		return nil
+	}
+
+	var steps v1.WorkflowStepList
+	if err := req.List(&steps, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.workflowExecutionName": wfe.Name}),
+		Namespace:     step.Namespace,
+	}); err != nil {
+		return err
+	}
+
+	// Nested steps are applied by the step that contains them, so they are found by their owner.
+	stepNames := map[string]bool{step.Name: true}
+	for found := true; found; {
+		found = false
+		for _, s := range steps.Items {
+			if !stepNames[s.Name] && stepNames[s.Annotations[apply.LabelName]] {
+				stepNames[s.Name] = true
+				found = true
+			}
+		}
+	}
+
+	var runs v1.RunList
+	if err := req.List(&runs, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.threadName": wfe.Status.ThreadName}),
+		Namespace:     step.Namespace,
+	}); err != nil {
+		return err
+	}
+
+	for _, run := range runs.Items {
+		if !stepNames[run.Spec.WorkflowStepName] || run.Status.State.IsTerminal() || run.Status.State == gptscript.Continue {
+			continue
+		}
+		if err := invoke.AbortRun(req.Ctx, req.Client, run.Namespace, run.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stepTimeRemaining returns the time left until the step timeout, or nil if the step has no timeout.
+func stepTimeRemaining(step *v1.WorkflowStep) (*time.Duration, error) {
+	if step.Spec.Step.Timeout == "" || step.Status.StartTime == nil {
+		return nil, nil
+	}
+
+	timeout, err := time.ParseDuration(step.Spec.Step.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid step timeout %q: %w", step.Spec.Step.Timeout, err)
+	}
+
+	remaining := time.Until(step.Status.StartTime.Add(timeout))
+	return &remaining, nil
+}
+
+// checkWorkflowExecution stops the step if its workflow execution has finished or is past its timeout. The workflow
+// execution timeout aborts the thread, and without this a step that retries would start new runs in it. stopped is true
+// if the step must not proceed.
+func checkWorkflowExecution(req router.Request, step *v1.WorkflowStep) (stopped bool, _ error) {
+	var wfe v1.WorkflowExecution
+	if err := req.Get(&wfe, step.Namespace, step.Spec.WorkflowExecutionName); err != nil {
+		return false, err
+	}
+
+	if wfe.Status.WorkflowGeneration != step.Spec.WorkflowGeneration {
+		return false, nil
+	}
+
+	if wfe.Status.State.IsTerminal() {
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = fmt.Sprintf("workflow execution is already in state %s", wfe.Status.State)
+		return true, nil
+	}
+
+	if remaining, err := WorkflowExecutionTimeRemaining(&wfe); err == nil && remaining != nil && *remaining <= 0 {
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = fmt.Sprintf("workflow execution exceeded timeout of %s", wfe.Status.WorkflowManifest.Timeout)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// WorkflowExecutionTimeRemaining returns the time left until the workflow execution timeout, or nil if the execution has
+// no timeout.
+func WorkflowExecutionTimeRemaining(wfe *v1.WorkflowExecution) (*time.Duration, error) {
+	if wfe.Status.WorkflowManifest == nil || wfe.Status.WorkflowManifest.Timeout == "" || wfe.Status.StartTime == nil {
+		return nil, nil
+	}
+
+	timeout, err := time.ParseDuration(wfe.Status.WorkflowManifest.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workflow timeout %q: %w", wfe.Status.WorkflowManifest.Timeout, err)
+	}
+
+	remaining := time.Until(wfe.Status.StartTime.Add(timeout))
+	return &remaining, nil
+}
+
+// runTimeout returns the time left until the step timeout as the timeout for a new run, or zero for the default.
+func runTimeout(step *v1.WorkflowStep) time.Duration {
+	if remaining, err := stepTimeRemaining(step); err == nil && remaining != nil && *remaining > 0 {
+		return *remaining
+	}
+	return 0
+}
@@ -0,0 +1,173 @@
+package workflowstep
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPreconditionsStopRetryAfterWorkflowExecutionTimeout(t *testing.T) {
+	wfe := &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: "we1", Namespace: "default"},
+		Spec:       v1.WorkflowExecutionSpec{WorkflowGeneration: 1},
+		Status: v1.WorkflowExecutionStatus{
+			State:              types.WorkflowStateRunning,
+			WorkflowGeneration: 1,
+			WorkflowManifest:   &types.WorkflowManifest{Timeout: "1m"},
+			StartTime:          &metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+		},
+	}
+	step := &v1.WorkflowStep{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws1", Namespace: "default"},
+		Spec: v1.WorkflowStepSpec{
+			WorkflowExecutionName: wfe.Name,
+			WorkflowGeneration:    1,
+			Step: types.Step{
+				ID:    "step1",
+				Step:  "do something",
+				Retry: &types.Retry{MaxAttempts: 3},
+			},
+		},
+		Status: v1.WorkflowStepStatus{
+			// The step is waiting to retry a failed attempt.
+			State:    types.WorkflowStateRunning,
+			RunNames: []string{"run1"},
+		},
+	}
+
+	h := New(nil, "", nil)
+	called := false
+	handler := h.Preconditions(router.HandlerFunc(func(router.Request, router.Response) error {
+		called = true
+		return nil
+	}))
+
+	err := handler.Handle(router.Request{
+		Ctx:    context.Background(),
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(wfe).Build(),
+		Object: step,
+	}, &testResponse{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if called {
+		t.Error("expected the step to not proceed after the workflow execution timed out")
+	}
+	if step.Status.State != types.WorkflowStateError {
+		t.Errorf("expected state %s, got %s", types.WorkflowStateError, step.Status.State)
+	}
+}
+
+func TestPreconditionsStopSubflowsAfterStepTimeout(t *testing.T) {
+	wfe := &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: "we1", Namespace: "default"},
+		Spec:       v1.WorkflowExecutionSpec{WorkflowGeneration: 1},
+		Status: v1.WorkflowExecutionStatus{
+			State:              types.WorkflowStateRunning,
+			WorkflowGeneration: 1,
+		},
+	}
+	step := &v1.WorkflowStep{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws1-step1", Namespace: "default"},
+		Spec: v1.WorkflowStepSpec{
+			WorkflowExecutionName: wfe.Name,
+			WorkflowGeneration:    1,
+			Step: types.Step{
+				ID:      "step1",
+				Step:    "call the other workflow",
+				Timeout: "1m",
+			},
+		},
+		Status: v1.WorkflowStepStatus{
+			State:     types.WorkflowStateSubCall,
+			StartTime: &metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+			RunNames:  []string{"run1"},
+			SubCalls:  []v1.SubCall{{Workflow: "other"}},
+		},
+	}
+	subflow := &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: subflowExecutionName(step, 0, step.Status.SubCalls[0]), Namespace: "default"},
+		Status:     v1.WorkflowExecutionStatus{State: types.WorkflowStateRunning},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(wfe, subflow).Build()
+
+	handler := New(nil, "", nil).Preconditions(router.HandlerFunc(func(router.Request, router.Response) error {
+		return nil
+	}))
+	if err := handler.Handle(router.Request{
+		Ctx:    context.Background(),
+		Client: c,
+		Object: step,
+	}, &testResponse{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if step.Status.State != types.WorkflowStateError {
+		t.Errorf("expected state %s, got %s", types.WorkflowStateError, step.Status.State)
+	}
+	if err := c.Get(context.Background(), kclient.ObjectKeyFromObject(subflow), &v1.WorkflowExecution{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the subflow execution to be stopped, got %v", err)
+	}
+}
+
+func TestAbortStepRunsLeavesOtherBranches(t *testing.T) {
+	wfe := &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: "we1", Namespace: "default"},
+		Status:     v1.WorkflowExecutionStatus{ThreadName: "t1"},
+	}
+	branchStep := func(name, owner string) *v1.WorkflowStep {
+		return &v1.WorkflowStep{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				Annotations: map[string]string{apply.LabelName: owner},
+			},
+			Spec: v1.WorkflowStepSpec{WorkflowExecutionName: wfe.Name},
+		}
+	}
+	run := func(name, stepName string) *v1.Run {
+		return &v1.Run{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       v1.RunSpec{ThreadName: "t1", WorkflowStepName: stepName},
+			Status:     v1.RunStatus{State: gptscript.Running},
+		}
+	}
+
+	parallel := branchStep("ws1-parallel", "")
+	timedOut := branchStep("ws1-slow", "")
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+		WithObjects(parallel, timedOut, branchStep("ws1-slow-nested", timedOut.Name), branchStep("ws1-fast", parallel.Name),
+			run("r1", "ws1-slow"), run("r2", "ws1-slow-nested"), run("r3", "ws1-fast")).
+		WithIndex(&v1.WorkflowStep{}, "spec.workflowExecutionName", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.WorkflowStep).Spec.WorkflowExecutionName}
+		}).
+		WithIndex(&v1.Run{}, "spec.threadName", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.Run).Spec.ThreadName}
+		}).Build()
+
+	if err := abortStepRuns(router.Request{Ctx: context.Background(), Client: c}, timedOut, wfe); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, aborted := range map[string]bool{"r1": true, "r2": true, "r3": false} {
+		var r v1.Run
+		if err := c.Get(context.Background(), router.Key("default", name), &r); err != nil {
+			t.Fatal(err)
+		}
+		if r.Spec.Abort != aborted {
+			t.Errorf("expected run %s aborted to be %v", name, aborted)
+		}
+	}
+}
@@ -51,36 +51,64 @@ func (h *Handler) RunWhile(req router.Request, _ router.Response) (err error) {
 			return nil
 		}
 
-		conditionStep := h.defineCondition(step, lastStep, i)
-		objects = append(objects, conditionStep)
-
-		if _, errMsg, state, err := GetStateFromSteps(req.Ctx, req.Client, step.Spec.WorkflowGeneration, conditionStep); err != nil {
-			return err
-		} else if state.IsBlocked() {
-			step.Status.State = state
-			step.Status.Error = errMsg
-			return nil
+		var (
+			conditionStep   *v1.WorkflowStep
+			conditionResult bool
+			afterStepName   = step.Spec.AfterWorkflowStepName
+		)
+
+		if lastStep != nil {
+			afterStepName = lastStep.Name
 		}
 
-		runName, conditionResult, wait, err := getConditionResult(req.Ctx, req.Client, step, conditionStep)
-		if err != nil {
-			return err
-		}
-		lastRunName = runName
-
-		if wait {
-			step.Status.State = types.WorkflowStateRunning
-			return nil
+		if step.Spec.Step.While.Expression != "" {
+			runName, result, failed, err := evaluateExpression(req.Ctx, req.Client, step, step.Spec.Step.While.Expression, afterStepName)
+			if err != nil || failed {
+				return err
+			}
+			lastRunName = runName
+			conditionResult = result
+		} else {
+			conditionStep = h.defineCondition(step, lastStep, i)
+			objects = append(objects, conditionStep)
+
+			if _, errMsg, state, err := GetStateFromSteps(req.Ctx, req.Client, step.Spec.WorkflowGeneration, conditionStep); err != nil {
+				return err
+			} else if state.IsBlocked() {
+				step.Status.State = state
+				step.Status.Error = errMsg
+				return nil
+			}
+
+			runName, result, wait, err := getConditionResult(req.Ctx, req.Client, step, conditionStep)
+			if err != nil {
+				return err
+			}
+			lastRunName = runName
+
+			if wait {
+				step.Status.State = types.WorkflowStateRunning
+				return nil
+			}
+
+			conditionResult = result
+			afterStepName = conditionStep.Name
 		}
 
 		if !conditionResult {
+			if lastRunName == "" {
+				// Only possible with an expression on the first step of a workflow, there is no output to pass on.
+				step.Status.State = types.WorkflowStateError
+				step.Status.Error = "While step ran no steps and has no previous step output to pass on"
+				return nil
+			}
 			completeResponse = true
 			step.Status.State = types.WorkflowStateComplete
 			step.Status.LastRunName = lastRunName
 			return nil
 		}
 
-		steps, err := h.defineWhile(i, conditionStep, step)
+		steps, err := h.defineWhile(i, afterStepName, step)
 		if err != nil {
 			return err
 		}
@@ -88,7 +116,7 @@ func (h *Handler) RunWhile(req router.Request, _ router.Response) (err error) {
 		if len(steps) > 0 {
 			lastWfStep := steps[len(steps)-1].(*v1.WorkflowStep)
 			lastStep = lastWfStep
-		} else {
+		} else if conditionStep != nil {
 			lastStep = conditionStep
 		}
 
@@ -118,20 +146,16 @@ func (h *Handler) RunWhile(req router.Request, _ router.Response) (err error) {
 	return nil
 }
 
-func (h *Handler) defineWhile(groupIndex int, conditionStep, step *v1.WorkflowStep) (result []kclient.Object, _ error) {
+func (h *Handler) defineWhile(groupIndex int, afterStepName string, step *v1.WorkflowStep) (result []kclient.Object, _ error) {
 	steps := step.Spec.Step.While.Steps
 
 	var (
-		lastStepName string
+		lastStepName = afterStepName
 	)
 
-	for i, loopStep := range steps {
-		afterStepName := conditionStep.Name
-		if i > 0 {
-			afterStepName = lastStepName
-		}
+	for _, loopStep := range steps {
 		loopStep.ID = fmt.Sprintf("%s{index=%d}", loopStep.ID, groupIndex)
-		newStep := NewStep(step.Namespace, step.Spec.WorkflowExecutionName, afterStepName, step.Spec.WorkflowGeneration, loopStep)
+		newStep := NewStep(step.Namespace, step.Spec.WorkflowExecutionName, lastStepName, step.Spec.WorkflowGeneration, loopStep)
 		result = append(result, newStep)
 		lastStepName = newStep.Name
 	}
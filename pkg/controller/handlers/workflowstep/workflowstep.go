@@ -8,7 +8,9 @@ import (
 	"github.com/obot-platform/nah/pkg/name"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gz"
 	"github.com/obot-platform/obot/pkg/invoke"
+	"github.com/obot-platform/obot/pkg/smtp"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -17,12 +19,16 @@ import (
 )
 
 type Handler struct {
-	invoker *invoke.Invoker
+	invoker   *invoke.Invoker
+	serverURL string
+	relay     *smtp.Relay
 }
 
-func New(invoker *invoke.Invoker) *Handler {
+func New(invoker *invoke.Invoker, serverURL string, relay *smtp.Relay) *Handler {
 	return &Handler{
-		invoker: invoker,
+		invoker:   invoker,
+		serverURL: serverURL,
+		relay:     relay,
 	}
 }
 
@@ -74,6 +80,11 @@ func deleteLastRuns(ctx context.Context, client kclient.Client, step *v1.Workflo
 
 	step.Status.LastRunName = ""
 	step.Status.RunNames = nil
+	step.Status.RetryCount = 0
+	step.Status.AttemptRunOffset = 0
+	step.Status.OutputSchemaCorrections = 0
+	step.Status.Approval = nil
+	step.Status.StartTime = nil
 	return nil
 }
 
@@ -85,7 +96,17 @@ func (h *Handler) Preconditions(next router.Handler) router.Handler {
 
 		if proceed, err := h.checkPreconditions(req, resp); err != nil {
 			return err
-		} else if proceed {
+		} else if !proceed {
+			return nil
+		}
+
+		if stopped, err := checkWorkflowExecution(req, req.Object.(*v1.WorkflowStep)); err != nil || stopped {
+			return err
+		}
+
+		if expired, err := checkTimeout(req, resp, req.Object.(*v1.WorkflowStep)); err != nil {
+			return err
+		} else if !expired {
 			return next.Handle(req, resp)
 		}
 
@@ -100,6 +121,8 @@ func (h *Handler) checkPreconditions(req router.Request, _ router.Response) (pro
 		if !step.IsGenerationInSync() {
 			// We are rerunning, reset the state and reprocess
 			step.Status.State = types.WorkflowStatePending
+			step.Status.Approval = nil
+			step.Status.StartTime = nil
 			return false, nil
 		}
 		// When terminal we no longer process anything
@@ -216,6 +239,19 @@ func GetStateFromSteps[T kclient.Object](ctx context.Context, client kclient.Cli
 	return "", "", types.WorkflowStateRunning, nil
 }
 
+// getRunOutput returns the complete output of a run. Run.Status.Output is truncated, so the output is
+// read from the run state.
+func getRunOutput(ctx context.Context, c kclient.Client, namespace, runName string) (string, error) {
+	var (
+		runState v1.RunState
+		output   string
+	)
+	if err := c.Get(ctx, router.Key(namespace, runName), &runState); err != nil {
+		return "", err
+	}
+	return output, gz.Decompress(&output, runState.Spec.Output)
+}
+
 var replaceRegexp = regexp.MustCompile(`[{},=]+`)
 
 func NewStep(namespace, workflowExecutionName, afterStepName string, generation int64, step types.Step) *v1.WorkflowStep {
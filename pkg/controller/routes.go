@@ -6,10 +6,12 @@ import (
 	"github.com/obot-platform/obot/pkg/controller/handlers/alias"
 	"github.com/obot-platform/obot/pkg/controller/handlers/cleanup"
 	"github.com/obot-platform/obot/pkg/controller/handlers/cronjob"
+	"github.com/obot-platform/obot/pkg/controller/handlers/emailreply"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgefile"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgeset"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgesource"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgesummary"
+	"github.com/obot-platform/obot/pkg/controller/handlers/notification"
 	"github.com/obot-platform/obot/pkg/controller/handlers/oauthapp"
 	"github.com/obot-platform/obot/pkg/controller/handlers/runs"
 	"github.com/obot-platform/obot/pkg/controller/handlers/threads"
@@ -26,7 +28,7 @@ func (c *Controller) setupRoutes() error {
 	root := c.router
 
 	workflowExecution := workflowexecution.New(c.services.Invoker)
-	workflowStep := workflowstep.New(c.services.Invoker)
+	workflowStep := workflowstep.New(c.services.Invoker, c.services.ServerURL, c.services.EmailRelay)
 	toolRef := toolreference.New(c.services.GPTClient, c.services.ModelProviderDispatcher, c.services.ToolRegistryURL)
 	workspace := workspace.New(c.services.GPTClient, c.services.WorkspaceProviderType)
 	knowledgeset := knowledgeset.New(c.services.AIHelper, c.services.Invoker)
@@ -35,6 +37,8 @@ func (c *Controller) setupRoutes() error {
 	runs := runs.New(c.services.Invoker)
 	webHooks := webhook.New()
 	cronJobs := cronjob.New()
+	notifications := notification.New()
+	emailReplies := emailreply.New(c.services.EmailRelay)
 	oauthLogins := oauthapp.NewLogin(c.services.Invoker, c.services.ServerURL)
 	knowledgesummary := knowledgesummary.NewHandler(c.services.GPTClient)
 
@@ -43,6 +47,7 @@ func (c *Controller) setupRoutes() error {
 	root.Type(&v1.Run{}).HandlerFunc(runs.DeleteFinished)
 	root.Type(&v1.Run{}).HandlerFunc(cleanup.Cleanup)
 	root.Type(&v1.Run{}).HandlerFunc(runs.Resume)
+	root.Type(&v1.Run{}).HandlerFunc(notifications.Run)
 
 	// Threads
 	root.Type(&v1.Thread{}).HandlerFunc(cleanup.Cleanup)
@@ -64,6 +69,8 @@ func (c *Controller) setupRoutes() error {
 	root.Type(&v1.WorkflowExecution{}).HandlerFunc(cleanup.Cleanup)
 	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.Run)
 	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.ReassignThread)
+	root.Type(&v1.WorkflowExecution{}).HandlerFunc(notifications.WorkflowExecution)
+	root.Type(&v1.WorkflowExecution{}).HandlerFunc(emailReplies.Reply)
 
 	// Agents
 	root.Type(&v1.Agent{}).HandlerFunc(agents.CreateWorkspaceAndKnowledgeSet)
@@ -112,6 +119,13 @@ func (c *Controller) setupRoutes() error {
 	root.Type(&v1.Webhook{}).HandlerFunc(cleanup.Cleanup)
 	root.Type(&v1.Webhook{}).HandlerFunc(alias.AssignAlias)
 	root.Type(&v1.Webhook{}).HandlerFunc(webHooks.SetSuccessRunTime)
+	root.Type(&v1.WebhookDelivery{}).HandlerFunc(cleanup.Cleanup)
+	root.Type(&v1.WebhookDelivery{}).HandlerFunc(webHooks.PruneDeliveries)
+
+	// NotificationTargets
+	root.Type(&v1.NotificationTarget{}).HandlerFunc(notifications.SetLastDelivery)
+	root.Type(&v1.NotificationDelivery{}).HandlerFunc(cleanup.Cleanup)
+	root.Type(&v1.NotificationDelivery{}).HandlerFunc(notifications.Deliver)
 
 	// Cronjobs
 	root.Type(&v1.CronJob{}).HandlerFunc(cleanup.Cleanup)
@@ -138,6 +152,9 @@ func (c *Controller) setupRoutes() error {
 	running.HandlerFunc(workflowStep.RunInvoke)
 	running.HandlerFunc(workflowStep.RunIf)
 	running.HandlerFunc(workflowStep.RunWhile)
+	running.HandlerFunc(workflowStep.RunParallel)
+	running.HandlerFunc(workflowStep.RunForEach)
+	running.HandlerFunc(workflowStep.RunApproval)
 	steps.HandlerFunc(workflowStep.RunSubflow)
 
 	c.toolRefHandler = toolRef
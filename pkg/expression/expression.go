@@ -0,0 +1,90 @@
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Evaluate runs a CEL expression against the given variables and returns its boolean result.
+func Evaluate(expression string, vars map[string]any) (bool, error) {
+	out, err := eval(expression, vars)
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q must evaluate to a bool, got %s", expression, out.Type().TypeName())
+	}
+	return result, nil
+}
+
+// EvaluateJSON runs a CEL expression against the given variables and returns its result encoded as JSON.
+func EvaluateJSON(expression string, vars map[string]any) (json.RawMessage, error) {
+	out, err := eval(expression, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("expression %q result can not be converted to JSON: %w", expression, err)
+	}
+	return json.Marshal(value.(*structpb.Value).AsInterface())
+}
+
+// Check compiles a CEL expression without running it so that errors can be reported before it is used.
+func Check(expression string, varNames ...string) error {
+	_, _, err := compile(expression, varNames)
+	return err
+}
+
+func compile(expression string, varNames []string) (*cel.Env, *cel.Ast, error) {
+	var opts []cel.EnvOption
+	for _, name := range varNames {
+		opts = append(opts, cel.Variable(name, cel.DynType))
+	}
+
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, nil, issues.Err()
+	}
+	return env, ast, nil
+}
+
+func eval(expression string, vars map[string]any) (ref.Val, error) {
+	env, ast, err := compile(expression, slices.Sorted(maps.Keys(vars)))
+	if err != nil {
+		return nil, err
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	out, _, err := program.Eval(vars)
+	return out, err
+}
+
+// Value converts a string into a value usable in an expression. JSON is decoded so that fields can be accessed,
+// anything else is returned as the plain string.
+func Value(s string) any {
+	var data any
+	if err := json.Unmarshal([]byte(s), &data); err == nil {
+		return data
+	}
+	return s
+}
@@ -0,0 +1,59 @@
+package expression
+
+import "testing"
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		expression string
+		vars       map[string]any
+		want       bool
+		wantErr    bool
+	}{
+		{`output == "yes"`, map[string]any{"output": Value("yes")}, true, false},
+		{`output.count > 3`, map[string]any{"output": Value(`{"count": 5}`)}, true, false},
+		{`input.action == "opened"`, map[string]any{"input": Value(`{"action": "closed"}`)}, false, false},
+		{`size(output.items) == 0`, map[string]any{"output": Value(`{"items": []}`)}, true, false},
+		{`"urgent" in input.labels`, map[string]any{"input": Value(`{"labels": ["urgent"]}`)}, true, false},
+		{`output.contains("error")`, map[string]any{"output": Value("an error occurred")}, true, false},
+		{`output`, map[string]any{"output": Value("yes")}, false, true},
+		{`missing == 1`, map[string]any{"output": Value("yes")}, false, true},
+		{`output ==`, map[string]any{"output": Value("yes")}, false, true},
+	}
+
+	for _, tt := range tests {
+		got, err := Evaluate(tt.expression, tt.vars)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Evaluate(%q) error = %v, wantErr %v", tt.expression, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Evaluate(%q) = %v, want %v", tt.expression, got, tt.want)
+		}
+	}
+}
+
+func TestEvaluateJSON(t *testing.T) {
+	vars := map[string]any{
+		"payload": Value(`{"action": "opened", "pull_request": {"title": "Fix", "number": 7}}`),
+	}
+
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{`payload.pull_request.title`, `"Fix"`},
+		{`{"title": payload.pull_request.title, "number": payload.pull_request.number}`, `{"number":7,"title":"Fix"}`},
+		{`"PR " + payload.action`, `"PR opened"`},
+	}
+
+	for _, tt := range tests {
+		got, err := EvaluateJSON(tt.expression, vars)
+		if err != nil {
+			t.Errorf("EvaluateJSON(%q) error = %v", tt.expression, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("EvaluateJSON(%q) = %s, want %s", tt.expression, got, tt.want)
+		}
+	}
+}
@@ -157,6 +157,7 @@ type Options struct {
 	ThreadCredentialScope *bool
 	UserUID               string
 	AgentAlias            string
+	Timeout               time.Duration
 }
 
 func (i *Invoker) getChatState(ctx context.Context, c kclient.Client, run *v1.Run) (result, lastThreadName string, _ error) {
@@ -317,6 +318,37 @@ func (i *Invoker) Agent(ctx context.Context, c kclient.WithWatch, agent *v1.Agen
 		WorkflowExecutionName: opt.WorkflowExecutionName,
 		PreviousRunName:       opt.PreviousRunName,
 		ForceNoResume:         opt.ForceNoResume,
+		Timeout:               opt.Timeout,
+	})
+}
+
+// AbortThread cancels the run currently running in the thread. The thread is un-aborted when the next run starts.
+func AbortThread(ctx context.Context, c kclient.Client, namespace, threadName string) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var thread v1.Thread
+		if err := c.Get(ctx, router.Key(namespace, threadName), uncached.Get(&thread)); err != nil {
+			return kclient.IgnoreNotFound(err)
+		}
+		if thread.Spec.Abort {
+			return nil
+		}
+		thread.Spec.Abort = true
+		return c.Update(ctx, &thread)
+	})
+}
+
+// AbortRun cancels a run. Unlike AbortThread, the other runs in the thread keep running.
+func AbortRun(ctx context.Context, c kclient.Client, namespace, runName string) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var run v1.Run
+		if err := c.Get(ctx, router.Key(namespace, runName), &run); err != nil {
+			return kclient.IgnoreNotFound(err)
+		}
+		if run.Spec.Abort || run.Status.State.IsTerminal() {
+			return nil
+		}
+		run.Spec.Abort = true
+		return c.Update(ctx, &run)
 	})
 }
 
@@ -706,6 +738,11 @@ func (i *Invoker) doSaveState(ctx context.Context, c kclient.Client, prevThreadN
 		}
 	}
 
+	if usage := tokenUsage(runResp.Calls()); final && run.Status.TokenUsage != usage {
+		run.Status.TokenUsage = usage
+		runChanged = true
+	}
+
 	if retErr != nil && !run.Status.State.IsTerminal() {
 		run.Status.State = gptscript.Error
 		if run.Status.Error == "" {
@@ -861,6 +898,7 @@ func (i *Invoker) stream(ctx context.Context, c kclient.WithWatch, prevThreadNam
 	}
 	go timeoutAfter(runCtx, cancelRun, timeout)
 	go watchThreadAbort(runCtx, c, thread, cancelRun)
+	go watchRunAbort(runCtx, c, run.DeepCopy(), cancelRun)
 
 	var (
 		abortTimeout = func() {}
@@ -921,6 +959,9 @@ func (i *Invoker) stream(ctx context.Context, c kclient.WithWatch, prevThreadNam
 					// In this case, we're waiting for an OAuth prompt
 					timeoutMsg = "timeout waiting for oauth"
 					timeout = 90 * time.Second
+					if err := setNeedsAuth(runCtx, c, run, metadata["authURL"]); err != nil {
+						return err
+					}
 					err := i.gptClient.PromptResponse(runCtx, gptscript.PromptResponse{
 						ID: frame.Prompt.ID,
 						Responses: map[string]string{
@@ -956,6 +997,34 @@ func (i *Invoker) stream(ctx context.Context, c kclient.WithWatch, prevThreadNam
 	}
 }
 
+// setNeedsAuth records that the run is waiting for the user to log in. The status is updated on a fresh copy of the run
+// because the run being streamed is saved concurrently.
+func setNeedsAuth(ctx context.Context, c kclient.Client, run *v1.Run, authURL string) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var current v1.Run
+		if err := c.Get(ctx, router.Key(run.Namespace, run.Name), uncached.Get(&current)); err != nil {
+			return err
+		}
+		if current.Status.NeedsAuth && current.Status.AuthURL == authURL {
+			return nil
+		}
+		current.Status.NeedsAuth = true
+		current.Status.AuthURL = authURL
+		return c.Status().Update(ctx, &current)
+	})
+}
+
+func tokenUsage(calls gptscript.CallFrames) (result types.TokenUsage) {
+	for _, call := range calls {
+		result = result.Add(types.TokenUsage{
+			PromptTokens:     call.Usage.PromptTokens,
+			CompletionTokens: call.Usage.CompletionTokens,
+			TotalTokens:      call.Usage.TotalTokens,
+		})
+	}
+	return result
+}
+
 func watchThreadAbort(ctx context.Context, c kclient.WithWatch, thread *v1.Thread, cancel context.CancelCauseFunc) {
 	_, _ = wait.For(ctx, c, thread, func(thread *v1.Thread) (bool, error) {
 		if thread.Spec.Abort {
@@ -968,6 +1037,18 @@ func watchThreadAbort(ctx context.Context, c kclient.WithWatch, thread *v1.Threa
 	})
 }
 
+func watchRunAbort(ctx context.Context, c kclient.WithWatch, run *v1.Run, cancel context.CancelCauseFunc) {
+	_, _ = wait.For(ctx, c, run, func(run *v1.Run) (bool, error) {
+		if run.Spec.Abort {
+			cancel(fmt.Errorf("run was aborted"))
+			return true, nil
+		}
+		return false, nil
+	}, wait.Option{
+		Timeout: 11 * time.Minute,
+	})
+}
+
 func timeoutAfter(ctx context.Context, cancel func(err error), d time.Duration) {
 	select {
 	case <-ctx.Done():
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -15,6 +16,10 @@ import (
 type StepOptions struct {
 	PreviousRunName string
 	Continue        *string
+	// Timeout is the maximum duration of the run, which is the time left until the step deadline.
+	Timeout time.Duration
+	// Approval is the decision of the previous step if it was an approval step.
+	Approval *v1.StepApproval
 }
 
 func (i *Invoker) Step(ctx context.Context, c kclient.WithWatch, step *v1.WorkflowStep, opt StepOptions) (*Response, error) {
@@ -23,20 +28,32 @@ func (i *Invoker) Step(ctx context.Context, c kclient.WithWatch, step *v1.Workfl
 		return nil, err
 	}
 
-	input, err := i.getInput(step)
-	if err != nil {
+	var wfe v1.WorkflowExecution
+	if err := c.Get(ctx, router.Key(step.Namespace, step.Spec.WorkflowExecutionName), &wfe); err != nil {
 		return nil, err
 	}
 
-	if opt.Continue != nil {
-		input = *opt.Continue
+	vars, err := i.loadVariables(ctx, c, &wfe)
+	if err != nil {
+		return nil, err
 	}
 
-	var wfe v1.WorkflowExecution
-	if err := c.Get(ctx, router.Key(step.Namespace, step.Spec.WorkflowExecutionName), &wfe); err != nil {
+	input, err := i.getInput(step, vars)
+	if err != nil {
 		return nil, err
 	}
 
+	if opt.Continue != nil {
+		input = *opt.Continue
+	} else if step.Spec.Step.Template == nil {
+		if opt.Approval != nil && opt.Approval.Comment != "" {
+			input = fmt.Sprintf("The previous step was approved by %s with the comment: %s\n\n%s", opt.Approval.Approver, opt.Approval.Comment, input)
+		}
+		if len(step.Spec.Step.OutputSchema) > 0 {
+			input = fmt.Sprintf("%s\n\nRespond with only JSON, and no other text, that matches this JSON schema:\n%s", input, step.Spec.Step.OutputSchema)
+		}
+	}
+
 	return i.Agent(ctx, c, &agent, input, Options{
 		ThreadName:            wfe.Status.ThreadName,
 		WorkflowStepName:      step.Name,
@@ -45,6 +62,7 @@ func (i *Invoker) Step(ctx context.Context, c kclient.WithWatch, step *v1.Workfl
 		PreviousRunName:       opt.PreviousRunName,
 		ForceNoResume:         opt.PreviousRunName == "",
 		ThreadCredentialScope: wfe.Spec.ThreadCredentialScope,
+		Timeout:               opt.Timeout,
 	})
 }
 
@@ -85,11 +103,19 @@ func toStringArgs(args map[string]string) (string, error) {
 	return string(data), err
 }
 
-func (i *Invoker) getInput(step *v1.WorkflowStep) (string, error) {
+func (i *Invoker) getInput(step *v1.WorkflowStep, vars variables) (string, error) {
 	if step.Spec.Step.Template != nil && step.Spec.Step.Template.Name != "" {
-		return toStringArgs(step.Spec.Step.Template.Args)
+		args := make(map[string]string, len(step.Spec.Step.Template.Args))
+		for k, v := range step.Spec.Step.Template.Args {
+			resolved, err := vars.resolve(v)
+			if err != nil {
+				return "", fmt.Errorf("failed to resolve template arg %q: %w", k, err)
+			}
+			args[k] = resolved
+		}
+		return toStringArgs(args)
 	} else if step.Spec.Step.Step != "" {
-		return step.Spec.Step.Step, nil
+		return vars.resolve(step.Spec.Step.Step)
 	}
 	return "", nil
 }
@@ -0,0 +1,147 @@
+package invoke
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gz"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var variableRegexp = regexp.MustCompile(`(\$+)\{\s*((?:input|steps)(?:\.[a-zA-Z0-9_-]+)*)\s*}`)
+
+type variables struct {
+	input string
+	steps map[string]string
+}
+
+func (i *Invoker) loadVariables(ctx context.Context, c kclient.Client, wfe *v1.WorkflowExecution) (variables, error) {
+	steps, err := StepOutputs(ctx, c, wfe.Namespace, wfe.Name)
+	if err != nil {
+		return variables{}, err
+	}
+	return variables{
+		input: wfe.Spec.Input,
+		steps: steps,
+	}, nil
+}
+
+// EscapeVariables escapes the variable references in text so that they are kept as is when the text is resolved. It is
+// used for data, such as step outputs, that is embedded into the text of a step.
+func EscapeVariables(text string) string {
+	return variableRegexp.ReplaceAllString(text, "$$$0")
+}
+
+// resolve replaces ${input}, ${input.<field>...} and ${steps.<name>.output} references in text. Other ${...} text is
+// left as is so that prompts can still contain things like shell variables. A reference escaped as $${...} is kept
+// with one less $.
+func (v variables) resolve(text string) (string, error) {
+	var resolveErr error
+	result := variableRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		submatches := variableRegexp.FindStringSubmatch(match)
+		if len(submatches[1]) > 1 {
+			return match[1:]
+		}
+		value, err := v.lookup(submatches[2])
+		if err != nil && resolveErr == nil {
+			resolveErr = err
+		}
+		return value
+	})
+	return result, resolveErr
+}
+
+func (v variables) lookup(ref string) (string, error) {
+	parts := strings.Split(ref, ".")
+	switch parts[0] {
+	case "input":
+		if len(parts) == 1 {
+			return v.input, nil
+		}
+		return lookupField(v.input, parts[1:])
+	case "steps":
+		if len(parts) < 3 || parts[2] != "output" {
+			return "", fmt.Errorf("invalid variable ${%s}, expected ${steps.<name>.output}", ref)
+		}
+		output, ok := v.steps[parts[1]]
+		if !ok {
+			return "", fmt.Errorf("invalid variable ${%s}, no completed step with output name %q", ref, parts[1])
+		}
+		if len(parts) == 3 {
+			return output, nil
+		}
+		return lookupField(output, parts[3:])
+	}
+	return "", fmt.Errorf("unknown variable ${%s}", ref)
+}
+
+func lookupField(content string, fields []string) (string, error) {
+	var data any
+	if err := json.Unmarshal([]byte(content), &data); err != nil {
+		return "", fmt.Errorf("can not look up %q, value is not JSON: %w", strings.Join(fields, "."), err)
+	}
+
+	for _, field := range fields {
+		obj, ok := data.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("can not look up %q, value is not an object", field)
+		}
+		if data, ok = obj[field]; !ok {
+			return "", fmt.Errorf("field %q not found", field)
+		}
+	}
+
+	if s, ok := data.(string); ok {
+		return s, nil
+	}
+	result, err := json.Marshal(data)
+	return string(result), err
+}
+
+// StepOutputs returns the complete output of each finished step of a workflow execution, keyed by the output name the step
+// declares. If a step ran more than once, as in a loop, the output of the most recent run is used.
+func StepOutputs(ctx context.Context, c kclient.Client, namespace, workflowExecutionName string) (map[string]string, error) {
+	var steps v1.WorkflowStepList
+	if err := c.List(ctx, &steps, kclient.InNamespace(namespace), kclient.MatchingFields{
+		"spec.workflowExecutionName": workflowExecutionName,
+	}); err != nil {
+		return nil, err
+	}
+
+	var (
+		latest = map[string]v1.WorkflowStep{}
+		result = map[string]string{}
+	)
+	for _, step := range steps.Items {
+		name := step.Spec.Step.OutputName
+		if name == "" || step.Status.State != types.WorkflowStateComplete || step.Status.LastRunName == "" {
+			continue
+		}
+		if existing, ok := latest[name]; ok && existing.CreationTimestamp.After(step.CreationTimestamp.Time) {
+			continue
+		}
+		latest[name] = step
+	}
+
+	for name, step := range latest {
+		var (
+			runState v1.RunState
+			output   string
+		)
+		if err := c.Get(ctx, router.Key(namespace, step.Status.LastRunName), &runState); err != nil {
+			return nil, err
+		}
+		if err := gz.Decompress(&output, runState.Spec.Output); err != nil {
+			return nil, err
+		}
+		result[name] = output
+	}
+
+	return result, nil
+}
@@ -0,0 +1,16 @@
+package invoke
+
+import "testing"
+
+func TestResolveEscapedVariables(t *testing.T) {
+	vars := variables{input: `{"name":"obot"}`}
+
+	data := "uses ${input.missing} and $${steps.x.output} and ${HOME}"
+	resolved, err := vars.resolve("Hello ${input.name}: " + EscapeVariables(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "Hello obot: " + data; resolved != expected {
+		t.Errorf("expected %q, got %q", expected, resolved)
+	}
+}
@@ -200,7 +200,7 @@ func (i *Invoker) rerunThread(ctx context.Context, c kclient.WithWatch, wf *v1.W
 	}
 
 	if stepID != "" {
-		if err := i.deleteSteps(ctx, c, thread, stepID); err != nil {
+		if err := i.deleteSteps(ctx, c, thread.Namespace, thread.Spec.WorkflowExecutionName, stepID); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -218,13 +218,47 @@ func (i *Invoker) rerunThread(ctx context.Context, c kclient.WithWatch, wf *v1.W
 	return &wfe, &thread, c.Update(ctx, &wfe)
 }
 
-func (i *Invoker) deleteSteps(ctx context.Context, c kclient.Client, thread v1.Thread, stepID string) error {
+// ResumeWorkflowExecution reruns a workflow execution from its failed steps, or from fromStepID if set. The completed steps
+// before that keep their runs, so their outputs are reused, and the steps after it are recreated as the workflow runs.
+func (i *Invoker) ResumeWorkflowExecution(ctx context.Context, c kclient.Client, namespace, workflowExecutionName, fromStepID string) (*v1.WorkflowExecution, error) {
+	var wfe v1.WorkflowExecution
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := c.Get(ctx, router.Key(namespace, workflowExecutionName), &wfe); err != nil {
+			return err
+		}
+
+		if err := i.deleteSteps(ctx, c, namespace, wfe.Name, fromStepID); err != nil {
+			return err
+		}
+
+		if wfe.Status.ThreadName != "" {
+			var thread v1.Thread
+			if err := c.Get(ctx, router.Key(namespace, wfe.Status.ThreadName), &thread); err != nil {
+				return err
+			}
+			if err := unAbortThread(ctx, c, &thread); err != nil {
+				return err
+			}
+		}
+
+		wfe.Spec.WorkflowGeneration++
+		wfe.Spec.RunUntilStep = ""
+		return c.Update(ctx, &wfe)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &wfe, nil
+}
+
+// deleteSteps deletes the failed steps of a workflow execution and the steps matching stepID.
+func (i *Invoker) deleteSteps(ctx context.Context, c kclient.Client, namespace, workflowExecutionName, stepID string) error {
 	var (
 		steps v1.WorkflowStepList
 	)
 
-	if err := c.List(ctx, &steps, kclient.InNamespace(thread.Namespace), kclient.MatchingFields{
-		"spec.workflowExecutionName": thread.Spec.WorkflowExecutionName,
+	if err := c.List(ctx, &steps, kclient.InNamespace(namespace), kclient.MatchingFields{
+		"spec.workflowExecutionName": workflowExecutionName,
 	}); err != nil {
 		return err
 	}
@@ -17,6 +17,7 @@ import (
 	baaah "github.com/obot-platform/nah"
 	"github.com/obot-platform/nah/pkg/leader"
 	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/aihelper"
 	"github.com/obot-platform/obot/pkg/api/authn"
 	"github.com/obot-platform/obot/pkg/api/authz"
@@ -44,25 +45,40 @@ import (
 	_ "github.com/obot-platform/nah/pkg/logrus"
 )
 
+var log = logger.Package()
+
 type (
 	AuthConfig    proxy.Config
 	GatewayConfig gserver.Options
 )
 
 type Config struct {
-	HTTPListenPort             int    `usage:"HTTP port to listen on" default:"8080" name:"http-listen-port"`
-	DevMode                    bool   `usage:"Enable development mode" default:"false" name:"dev-mode" env:"OBOT_DEV_MODE"`
-	DevUIPort                  int    `usage:"The port on localhost running the dev instance of the UI" default:"5173"`
-	AllowedOrigin              string `usage:"Allowed origin for CORS"`
-	ToolRegistry               string `usage:"The tool reference for the tool registry" default:"github.com/obot-platform/tools"`
-	WorkspaceProviderType      string `usage:"The type of workspace provider to use for non-knowledge workspaces" default:"directory" env:"OBOT_WORKSPACE_PROVIDER_TYPE"`
-	WorkspaceTool              string `usage:"The tool reference for the workspace provider" default:"github.com/gptscript-ai/workspace-provider"`
-	DatasetsTool               string `usage:"The tool reference for the dataset provider" default:"github.com/gptscript-ai/datasets"`
-	HelperModel                string `usage:"The model used to generate names and descriptions" default:"gpt-4o-mini"`
-	AWSKMSKeyARN               string `usage:"The ARN of the AWS KMS key to use for encrypting credential storage" env:"OBOT_AWS_KMS_KEY_ARN" name:"aws-kms-key-arn"`
-	EncryptionConfigFile       string `usage:"The path to the encryption configuration file" default:"./encryption.yaml"`
-	KnowledgeSetIngestionLimit int    `usage:"The maximum number of files to ingest into a knowledge set" default:"1000" env:"OBOT_KNOWLEDGESET_INGESTION_LIMIT" name:"knowledge-set-ingestion-limit"`
-	EmailServerName            string `usage:"The name of the email server to display for email receivers (default: ui-hostname value)"`
+	HTTPListenPort                 int    `usage:"HTTP port to listen on" default:"8080" name:"http-listen-port"`
+	DevMode                        bool   `usage:"Enable development mode" default:"false" name:"dev-mode" env:"OBOT_DEV_MODE"`
+	DevUIPort                      int    `usage:"The port on localhost running the dev instance of the UI" default:"5173"`
+	AllowedOrigin                  string `usage:"Allowed origin for CORS"`
+	ToolRegistry                   string `usage:"The tool reference for the tool registry" default:"github.com/obot-platform/tools"`
+	WorkspaceProviderType          string `usage:"The type of workspace provider to use for non-knowledge workspaces" default:"directory" env:"OBOT_WORKSPACE_PROVIDER_TYPE"`
+	WorkspaceTool                  string `usage:"The tool reference for the workspace provider" default:"github.com/gptscript-ai/workspace-provider"`
+	DatasetsTool                   string `usage:"The tool reference for the dataset provider" default:"github.com/gptscript-ai/datasets"`
+	HelperModel                    string `usage:"The model used to generate names and descriptions" default:"gpt-4o-mini"`
+	AWSKMSKeyARN                   string `usage:"The ARN of the AWS KMS key to use for encrypting credential storage" env:"OBOT_AWS_KMS_KEY_ARN" name:"aws-kms-key-arn"`
+	EncryptionConfigFile           string `usage:"The path to the encryption configuration file" default:"./encryption.yaml"`
+	KnowledgeSetIngestionLimit     int    `usage:"The maximum number of files to ingest into a knowledge set" default:"1000" env:"OBOT_KNOWLEDGESET_INGESTION_LIMIT" name:"knowledge-set-ingestion-limit"`
+	EmailServerName                string `usage:"The name of the email server to display for email receivers (default: ui-hostname value)"`
+	EmailServerAddress             string `usage:"The address the SMTP server for email receivers listens on" default:":2525"`
+	EmailServerTLSCertFile         string `usage:"The certificate file for STARTTLS on the SMTP server"`
+	EmailServerTLSKeyFile          string `usage:"The key file for STARTTLS on the SMTP server"`
+	EmailServerRequireTLS          bool   `usage:"Reject mail from clients that do not use STARTTLS"`
+	EmailServerMaxMessageSize      int    `usage:"The largest message in bytes the SMTP server accepts" default:"41943040"`
+	EmailServerMaxConnections      int    `usage:"The most connections the SMTP server serves at once" default:"100"`
+	EmailServerMaxConnectionsPerIP int    `usage:"The most connections the SMTP server serves at once from one IP address, 0 for no limit" default:"10"`
+	EmailServerRateLimit           int    `usage:"The most messages per minute the SMTP server accepts from one IP address, 0 for no limit" default:"30"`
+	EmailServerRequired            bool   `usage:"Fail startup if the SMTP server for email receivers can't start, instead of running without it"`
+	EmailRelayAddress              string `usage:"The host:port of the SMTP relay used to send email replies and approval requests"`
+	EmailRelayUsername             string `usage:"The username for the SMTP relay"`
+	EmailRelayPassword             string `usage:"The password for the SMTP relay"`
+	EmailRelayFrom                 string `usage:"The From address of email sent through the SMTP relay that is not a reply, such as approval requests"`
 
 	AuthConfig
 	GatewayConfig
@@ -74,6 +90,7 @@ type Services struct {
 	WorkspaceProviderType      string
 	ServerURL                  string
 	EmailServerName            string
+	EmailRelay                 *smtp.Relay
 	DevUIPort                  int
 	Events                     *events.Emitter
 	StorageClient              storage.Client
@@ -260,7 +277,23 @@ func New(ctx context.Context, config Config) (*Services, error) {
 	}
 
 	if config.EmailServerName != "" {
-		go smtp.Start(ctx, storageClient, config.EmailServerName)
+		if err := smtp.Start(ctx, storageClient, c, invoker, smtp.Options{
+			Hostname:             config.EmailServerName,
+			Address:              config.EmailServerAddress,
+			TLSCertFile:          config.EmailServerTLSCertFile,
+			TLSKeyFile:           config.EmailServerTLSKeyFile,
+			RequireTLS:           config.EmailServerRequireTLS,
+			MaxMessageSize:       config.EmailServerMaxMessageSize,
+			MaxConnections:       config.EmailServerMaxConnections,
+			MaxConnectionsPerIP:  config.EmailServerMaxConnectionsPerIP,
+			MaxMessagesPerMinute: config.EmailServerRateLimit,
+		}); err != nil {
+			if config.EmailServerRequired {
+				return nil, err
+			}
+			// Email receivers are optional, so the rest of the server keeps running without them.
+			log.Errorf("Failed to start SMTP server, email receivers will not receive email: %v", err)
+		}
 	}
 
 	// For now, always auto-migrate the gateway database
@@ -282,6 +315,7 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		ProxyServer:                proxyServer,
 		KnowledgeSetIngestionLimit: config.KnowledgeSetIngestionLimit,
 		EmailServerName:            config.EmailServerName,
+		EmailRelay:                 smtp.NewRelay(config.EmailRelayAddress, config.EmailRelayUsername, config.EmailRelayPassword, config.EmailRelayFrom),
 		ModelProviderDispatcher:    modelProviderDispatcher,
 	}, nil
 }
@@ -0,0 +1,117 @@
+package smtp
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gptscript-ai/go-gptscript"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/wait"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultMaxAttachmentSize      = 10 * 1024 * 1024
+	defaultMaxTotalAttachmentSize = 25 * 1024 * 1024
+)
+
+// attachmentsWithinLimits returns the attachments that are within the size limits of the receiver, and the
+// attachments to list in the input of the workflow.
+func attachmentsWithinLimits(email v1.EmailReceiver, attachments []attachment) ([]attachment, []attachmentInput) {
+	var (
+		maxSize      = int64(defaultMaxAttachmentSize)
+		maxTotalSize = int64(defaultMaxTotalAttachmentSize)
+		totalSize    int64
+		inputs       = make([]attachmentInput, 0, len(attachments))
+		accepted     = make([]attachment, 0, len(attachments))
+		names        = map[string]bool{}
+	)
+	if email.Spec.MaxAttachmentSize > 0 {
+		maxSize = email.Spec.MaxAttachmentSize
+	}
+	if email.Spec.MaxTotalAttachmentSize > 0 {
+		maxTotalSize = email.Spec.MaxTotalAttachmentSize
+	}
+
+	for _, a := range attachments {
+		a.Name = uniqueName(names, a.Name)
+		input := attachmentInput{
+			Name:        a.Name,
+			ContentType: a.ContentType,
+			Size:        len(a.Data),
+		}
+
+		size := int64(len(a.Data))
+		switch {
+		case size > maxSize:
+			input.Error = fmt.Sprintf("attachment is larger than the limit of %d bytes", maxSize)
+		case totalSize+size > maxTotalSize:
+			input.Error = fmt.Sprintf("attachments are larger than the total limit of %d bytes", maxTotalSize)
+		default:
+			totalSize += size
+			accepted = append(accepted, a)
+		}
+		inputs = append(inputs, input)
+	}
+
+	return accepted, inputs
+}
+
+// newAttachmentsWorkspace creates a workspace with the files of the workflow and writes the attachments into it. A
+// new workflow execution uses it as the workspace of its thread.
+func (s *Server) newAttachmentsWorkspace(workflow *v1.Workflow, attachments []attachment) (*v1.Workspace, error) {
+	var fromWorkspaceNames []string
+	if workflow.Status.WorkspaceName != "" {
+		fromWorkspaceNames = []string{workflow.Status.WorkspaceName}
+	}
+
+	workspace, err := wait.For(s.ctx, s.c, &v1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.WorkspacePrefix,
+			Namespace:    workflow.Namespace,
+			Finalizers:   []string{v1.WorkspaceFinalizer},
+		},
+		Spec: v1.WorkspaceSpec{
+			WorkflowName:       workflow.Name,
+			FromWorkspaceNames: fromWorkspaceNames,
+		},
+	}, func(ws *v1.Workspace) (bool, error) {
+		return ws.Status.WorkspaceID != "", nil
+	}, wait.Option{
+		Create: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.writeAttachments(workspace.Status.WorkspaceID, attachments); err != nil {
+		_ = s.c.Delete(s.ctx, workspace)
+		return nil, err
+	}
+
+	return workspace, nil
+}
+
+func (s *Server) writeAttachments(workspaceID string, attachments []attachment) error {
+	for _, a := range attachments {
+		if err := s.gptClient.WriteFileInWorkspace(s.ctx, "files/"+a.Name, a.Data, gptscript.WriteFileInWorkspaceOptions{
+			WorkspaceID: workspaceID,
+		}); err != nil {
+			return fmt.Errorf("failed to write attachment %q: %w", a.Name, err)
+		}
+	}
+	return nil
+}
+
+// uniqueName returns the name, with a number added before its extension if an earlier attachment has the same name.
+func uniqueName(names map[string]bool, name string) string {
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for i := 1; names[name]; i++ {
+		name = fmt.Sprintf("%s-%d%s", base, i, ext)
+	}
+	names[name] = true
+	return name
+}
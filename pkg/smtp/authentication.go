@@ -0,0 +1,182 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"blitiri.com.ar/go/spf"
+	"github.com/emersion/go-msgauth/dkim"
+	"github.com/emersion/go-msgauth/dmarc"
+	"golang.org/x/net/publicsuffix"
+)
+
+const (
+	authenticationTimeout = 20 * time.Second
+	maxDKIMSignatures     = 5
+)
+
+// authenticationResults are the results of the SPF, DKIM and DMARC checks of an email. They are included in the input
+// of the workflow.
+type authenticationResults struct {
+	// SPF is the result of the SPF check of the envelope sender domain for the IP address the email came from.
+	SPF string `json:"spf"`
+	// DKIM is "pass" if any DKIM signature is valid, "fail" if there are only invalid signatures, and "none" if the
+	// email is not signed.
+	DKIM string `json:"dkim"`
+	// DKIMDomains are the domains of the valid DKIM signatures.
+	DKIMDomains []string `json:"dkimDomains,omitempty"`
+	// DMARC is the DMARC result for the domain of the From header, or "none" if the domain has no DMARC record.
+	DMARC string `json:"dmarc"`
+	// Authenticated is true if a passing SPF or DKIM check is aligned with the domain of the From header.
+	Authenticated bool `json:"authenticated"`
+}
+
+// authenticator checks SPF, DKIM and DMARC for received email. The lookups are fields so that they can be replaced
+// in tests.
+type authenticator struct {
+	lookupTXT func(ctx context.Context, domain string) ([]string, error)
+	checkSPF  func(ctx context.Context, ip net.IP, sender string) (spf.Result, error)
+}
+
+func newAuthenticator() *authenticator {
+	return &authenticator{
+		lookupTXT: net.DefaultResolver.LookupTXT,
+		checkSPF: func(ctx context.Context, ip net.IP, sender string) (spf.Result, error) {
+			return spf.CheckHostWithSender(ip, "", sender, spf.WithContext(ctx))
+		},
+	}
+}
+
+// authenticate checks the email that was received from remoteAddr with the envelope sender and From header
+// addresses.
+func (a *authenticator) authenticate(ctx context.Context, remoteAddr net.Addr, envelopeFrom, headerFrom string, data []byte) authenticationResults {
+	ctx, cancel := context.WithTimeout(ctx, authenticationTimeout)
+	defer cancel()
+
+	var (
+		results      = authenticationResults{SPF: string(spf.None), DKIM: "none", DMARC: "none"}
+		envelopeHost = domainOf(envelopeFrom)
+		fromDomain   = domainOf(headerFrom)
+	)
+
+	if ip := remoteIP(remoteAddr); ip != nil && envelopeHost != "" {
+		result, _ := a.checkSPF(ctx, ip, envelopeFrom)
+		results.SPF = string(result)
+	}
+
+	verifications, err := dkim.VerifyWithOptions(bytes.NewReader(data), &dkim.VerifyOptions{
+		LookupTXT: func(domain string) ([]string, error) {
+			return a.lookupTXT(ctx, domain)
+		},
+		MaxVerifications: maxDKIMSignatures,
+	})
+	if err != nil && !errors.Is(err, dkim.ErrTooManySignatures) {
+		results.DKIM = "permerror"
+	} else if len(verifications) > 0 {
+		results.DKIM = "fail"
+		for _, v := range verifications {
+			if v.Err == nil {
+				results.DKIM = "pass"
+				results.DKIMDomains = append(results.DKIMDomains, v.Domain)
+			}
+		}
+	}
+
+	if fromDomain == "" {
+		results.DMARC = "permerror"
+		return results
+	}
+
+	record, err := a.lookupDMARC(ctx, fromDomain)
+	if err != nil {
+		switch {
+		case errors.Is(err, dmarc.ErrNoPolicy):
+		case dmarc.IsTempFail(err):
+			results.DMARC = "temperror"
+		default:
+			results.DMARC = "permerror"
+		}
+		// Without a record, the alignment is checked the same way as with the default relaxed record.
+		record = &dmarc.Record{}
+	}
+
+	spfAligned := results.SPF == string(spf.Pass) && aligned(envelopeHost, fromDomain, record.SPFAlignment)
+	dkimAligned := false
+	for _, domain := range results.DKIMDomains {
+		if aligned(domain, fromDomain, record.DKIMAlignment) {
+			dkimAligned = true
+			break
+		}
+	}
+
+	results.Authenticated = spfAligned || dkimAligned
+	if err == nil {
+		if results.Authenticated {
+			results.DMARC = "pass"
+		} else {
+			results.DMARC = "fail"
+		}
+	}
+
+	return results
+}
+
+// lookupDMARC returns the DMARC record of the domain, or of its organizational domain if the domain has none.
+func (a *authenticator) lookupDMARC(ctx context.Context, domain string) (*dmarc.Record, error) {
+	options := &dmarc.LookupOptions{
+		LookupTXT: func(domain string) ([]string, error) {
+			return a.lookupTXT(ctx, domain)
+		},
+	}
+
+	record, err := dmarc.LookupWithOptions(domain, options)
+	if errors.Is(err, dmarc.ErrNoPolicy) {
+		if orgDomain := organizationalDomain(domain); orgDomain != domain {
+			return dmarc.LookupWithOptions(orgDomain, options)
+		}
+	}
+	return record, err
+}
+
+// aligned returns true if the authenticated domain matches the From header domain for the alignment mode. Relaxed
+// alignment only needs the organizational domains to match.
+func aligned(domain, fromDomain string, mode dmarc.AlignmentMode) bool {
+	if strings.EqualFold(domain, fromDomain) {
+		return true
+	}
+	if mode == dmarc.AlignmentStrict {
+		return false
+	}
+	return organizationalDomain(domain) == organizationalDomain(fromDomain)
+}
+
+func organizationalDomain(domain string) string {
+	domain = strings.ToLower(domain)
+	if orgDomain, err := publicsuffix.EffectiveTLDPlusOne(domain); err == nil {
+		return orgDomain
+	}
+	return domain
+}
+
+func domainOf(address string) string {
+	_, domain, _ := strings.Cut(address, "@")
+	return strings.ToLower(domain)
+}
+
+func remoteIP(addr net.Addr) net.IP {
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+		return tcpAddr.IP
+	}
+	return nil
+}
+
+func remoteIPString(addr net.Addr) string {
+	if ip := remoteIP(addr); ip != nil {
+		return ip.String()
+	}
+	return addr.String()
+}
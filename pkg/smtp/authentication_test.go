@@ -0,0 +1,116 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"net"
+	"strings"
+	"testing"
+
+	"blitiri.com.ar/go/spf"
+	"github.com/emersion/go-msgauth/dkim"
+)
+
+func TestAuthenticate(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := strings.ReplaceAll(`From: alice@mail.example.com
+To: receiver@obot.example.com
+Subject: Hello
+
+Hello
+`, "\n", "\r\n")
+
+	var signed bytes.Buffer
+	if err := dkim.Sign(&signed, strings.NewReader(message), &dkim.SignOptions{
+		Domain:     "example.com",
+		Selector:   "s1",
+		Signer:     privateKey,
+		HeaderKeys: []string{"From", "To", "Subject"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	records := map[string][]string{
+		"s1._domainkey.example.com": {"v=DKIM1; k=ed25519; p=" + base64.StdEncoding.EncodeToString(publicKey)},
+		"_dmarc.example.com":        {"v=DMARC1; p=reject"},
+	}
+	a := &authenticator{
+		lookupTXT: func(_ context.Context, domain string) ([]string, error) {
+			if txt, ok := records[domain]; ok {
+				return txt, nil
+			}
+			return nil, &net.DNSError{Err: "no such host", Name: domain, IsNotFound: true}
+		},
+		checkSPF: func(_ context.Context, _ net.IP, sender string) (spf.Result, error) {
+			if strings.HasSuffix(sender, "@bounces.example.org") {
+				return spf.Pass, nil
+			}
+			return spf.Fail, nil
+		},
+	}
+	remoteAddr := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 25}
+
+	tests := []struct {
+		name          string
+		envelopeFrom  string
+		headerFrom    string
+		data          []byte
+		spf, dkim     string
+		dmarc         string
+		authenticated bool
+	}{
+		{
+			name:          "aligned dkim",
+			envelopeFrom:  "alice@mail.example.com",
+			headerFrom:    "alice@mail.example.com",
+			data:          signed.Bytes(),
+			spf:           "fail",
+			dkim:          "pass",
+			dmarc:         "pass",
+			authenticated: true,
+		},
+		{
+			name:         "spf not aligned",
+			envelopeFrom: "bounce@bounces.example.org",
+			headerFrom:   "alice@mail.example.com",
+			data:         []byte(message),
+			spf:          "pass",
+			dkim:         "none",
+			dmarc:        "fail",
+		},
+		{
+			name:         "modified body",
+			envelopeFrom: "alice@mail.example.com",
+			headerFrom:   "alice@mail.example.com",
+			data:         bytes.Replace(signed.Bytes(), []byte("\r\n\r\nHello"), []byte("\r\n\r\nGoodbye"), 1),
+			spf:          "fail",
+			dkim:         "fail",
+			dmarc:        "fail",
+		},
+		{
+			name:          "spf aligned without dmarc record",
+			envelopeFrom:  "bounce@bounces.example.org",
+			headerFrom:    "bob@example.org",
+			data:          []byte(message),
+			spf:           "pass",
+			dkim:          "none",
+			dmarc:         "none",
+			authenticated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := a.authenticate(context.Background(), remoteAddr, tt.envelopeFrom, tt.headerFrom, tt.data)
+			if results.SPF != tt.spf || results.DKIM != tt.dkim || results.DMARC != tt.dmarc || results.Authenticated != tt.authenticated {
+				t.Errorf("unexpected results %+v", results)
+			}
+		})
+	}
+}
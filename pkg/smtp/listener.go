@@ -0,0 +1,88 @@
+package smtp
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// connectionLimitListener turns away connections from an IP address that already has too many connections open or
+// has sent too many messages, so that one client can't hold every connection the server serves at once.
+type connectionLimitListener struct {
+	net.Listener
+	perIP    int
+	limiters *senderRateLimiters
+
+	lock sync.Mutex
+	open map[string]int
+}
+
+func newConnectionLimitListener(ln net.Listener, perIP int, limiters *senderRateLimiters) *connectionLimitListener {
+	return &connectionLimitListener{
+		Listener: ln,
+		perIP:    perIP,
+		limiters: limiters,
+		open:     map[string]int{},
+	}
+}
+
+func (l *connectionLimitListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		ip := remoteIPString(conn.RemoteAddr())
+		if reason := l.acquire(ip); reason != "" {
+			log.Infof("Rejecting connection from %s: %s", ip, reason)
+			// A 421 reply makes the sender close the connection and retry later.
+			_ = conn.SetWriteDeadline(time.Now().Add(time.Second))
+			_, _ = fmt.Fprintf(conn, "421 4.7.0 %s, try again later\r\n", reason)
+			_ = conn.Close()
+			continue
+		}
+
+		return &limitedConn{Conn: conn, release: func() { l.release(ip) }}, nil
+	}
+}
+
+// acquire counts a new connection from the IP address, or returns why it isn't allowed.
+func (l *connectionLimitListener) acquire(ip string) string {
+	if l.limiters.limited(ip, time.Now()) {
+		return "Too many messages"
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.perIP > 0 && l.open[ip] >= l.perIP {
+		return "Too many connections"
+	}
+	l.open[ip]++
+	return ""
+}
+
+func (l *connectionLimitListener) release(ip string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.open[ip] <= 1 {
+		delete(l.open, ip)
+	} else {
+		l.open[ip]--
+	}
+}
+
+type limitedConn struct {
+	net.Conn
+	once    sync.Once
+	release func()
+}
+
+func (c *limitedConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(c.release)
+	return err
+}
@@ -0,0 +1,63 @@
+package smtp
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestConnectionLimitListenerPerIP(t *testing.T) {
+	base, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln := newConnectionLimitListener(base, 1, nil)
+	defer ln.Close()
+
+	accepted := make(chan net.Conn)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				close(accepted)
+				return
+			}
+			accepted <- conn
+		}
+	}()
+
+	first, err := net.Dial("tcp", base.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer first.Close()
+	served := <-accepted
+
+	// The second connection from the same address is turned away while the first is open.
+	second, err := net.Dial("tcp", base.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+	line, err := bufio.NewReader(second).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(line, "421 ") {
+		t.Errorf("expected a 421 reply, got %q", line)
+	}
+
+	// Once the first connection is closed, the address can connect again.
+	_ = served.Close()
+	third, err := net.Dial("tcp", base.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer third.Close()
+	if conn := <-accepted; conn == nil {
+		t.Fatal("expected the connection to be accepted")
+	} else {
+		_ = conn.Close()
+	}
+}
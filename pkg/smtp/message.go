@@ -0,0 +1,179 @@
+package smtp
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"path"
+	"strings"
+
+	"github.com/jaytaylor/html2text"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+type attachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+type parsedMessage struct {
+	text        string
+	html        string
+	attachments []attachment
+}
+
+// parseMessage walks the MIME parts of the message, including nested multipart parts, and collects the text body and
+// the attachments.
+func parseMessage(message *mail.Message) (*parsedMessage, error) {
+	var p parsedMessage
+	if err := p.walk(textproto.MIMEHeader(message.Header), message.Body); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// body returns the text/plain body, or the text/html body converted to plain text if there is no text/plain part.
+func (p *parsedMessage) body() (string, error) {
+	if p.text != "" {
+		return p.text, nil
+	}
+	if p.html != "" {
+		text, err := html2text.FromString(p.html)
+		if err != nil {
+			return "", fmt.Errorf("failed to convert text/html body: %w", err)
+		}
+		return text, nil
+	}
+	return "", errors.New("failed to find text/plain body")
+}
+
+func (p *parsedMessage) walk(header textproto.MIMEHeader, r io.Reader) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil && !errors.Is(err, mime.ErrInvalidMediaParameter) {
+		mediaType = ""
+	}
+	if mediaType == "" {
+		// RFC 2045: a part without a valid Content-Type is plain US-ASCII text.
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(r, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if err := p.walk(part.Header, part); err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := decodeTransferEncoding(header.Get("Content-Transfer-Encoding"), r)
+	if err != nil {
+		return err
+	}
+
+	name := attachmentName(header, params)
+	switch {
+	case name == "" && mediaType == "text/plain" && p.text == "":
+		p.text = decodeCharset(params["charset"], data)
+	case name == "" && mediaType == "text/html" && p.html == "":
+		p.html = decodeCharset(params["charset"], data)
+	case name != "" || !strings.HasPrefix(mediaType, "text/"):
+		if name == "" {
+			name = "attachment"
+		}
+		p.attachments = append(p.attachments, attachment{
+			Name:        name,
+			ContentType: mediaType,
+			Data:        data,
+		})
+	}
+
+	return nil
+}
+
+// attachmentName returns the file name of the part from its Content-Disposition or Content-Type header. Parts that
+// are marked as attachments but have no file name get a generic name.
+func attachmentName(header textproto.MIMEHeader, contentTypeParams map[string]string) string {
+	disposition, params, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	name := params["filename"]
+	if name == "" {
+		name = contentTypeParams["name"]
+	}
+	if name == "" && disposition == "attachment" {
+		name = "attachment"
+	}
+	if name == "" {
+		return ""
+	}
+
+	// The name comes from the sender, so only keep the last element of any path in it.
+	name = path.Base(strings.ReplaceAll(decodeHeader(name), "\\", "/"))
+	if name == "." || name == ".." || name == "/" {
+		return "attachment"
+	}
+	return name
+}
+
+// decodeCharset converts text in the charset to UTF-8. Text in an unknown charset has its invalid bytes replaced.
+func decodeCharset(charset string, data []byte) string {
+	charset = strings.ToLower(strings.TrimSpace(charset))
+	if charset != "" && charset != "utf-8" && charset != "us-ascii" {
+		if enc, err := htmlindex.Get(charset); err == nil {
+			if decoded, err := enc.NewDecoder().Bytes(data); err == nil {
+				return string(decoded)
+			}
+		}
+	}
+	return strings.ToValidUTF8(string(data), "\uFFFD")
+}
+
+func decodeTransferEncoding(encoding string, r io.Reader) ([]byte, error) {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		data, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, r))
+		if err != nil {
+			return nil, fmt.Errorf("decode base64: %w", err)
+		}
+		return data, nil
+	case "quoted-printable":
+		data, err := io.ReadAll(quotedprintable.NewReader(r))
+		if err != nil {
+			return nil, fmt.Errorf("decode quoted-printable: %w", err)
+		}
+		return data, nil
+	default:
+		return io.ReadAll(r)
+	}
+}
+
+var headerDecoder = mime.WordDecoder{
+	CharsetReader: func(charset string, input io.Reader) (io.Reader, error) {
+		enc, err := htmlindex.Get(charset)
+		if err != nil {
+			return nil, err
+		}
+		return enc.NewDecoder().Reader(input), nil
+	},
+}
+
+// decodeHeader decodes the RFC 2047 encoded words in a header such as the Subject. The header is returned as is if it
+// can't be decoded.
+func decodeHeader(value string) string {
+	if decoded, err := headerDecoder.DecodeHeader(value); err == nil {
+		return decoded
+	}
+	return value
+}
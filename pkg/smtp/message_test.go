@@ -0,0 +1,159 @@
+package smtp
+
+import (
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+func TestParseMessageAttachments(t *testing.T) {
+	raw := strings.ReplaceAll(`From: sender@example.com
+To: receiver@example.com
+Subject: Report
+Content-Type: multipart/mixed; boundary="outer"
+
+--outer
+Content-Type: multipart/alternative; boundary="inner"
+
+--inner
+Content-Type: text/plain; charset=utf-8
+Content-Transfer-Encoding: quoted-printable
+
+Please see the attached =
+report.
+--inner
+Content-Type: text/html; charset=utf-8
+
+<p>Please see the attached report.</p>
+--inner--
+--outer
+Content-Type: application/pdf; name="report.pdf"
+Content-Disposition: attachment; filename="../report.pdf"
+Content-Transfer-Encoding: base64
+
+JVBERi0xLjQK
+--outer
+Content-Type: text/csv
+Content-Disposition: attachment; filename="data.csv"
+
+a,b
+1,2
+--outer
+Content-Type: text/csv
+Content-Disposition: attachment; filename="data.csv"
+
+c,d
+--outer--
+`, "\n", "\r\n")
+
+	message, err := mail.ReadMessage(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := parseMessage(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := parsed.body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != "Please see the attached report." {
+		t.Errorf("unexpected body %q", body)
+	}
+
+	if len(parsed.attachments) != 3 {
+		t.Fatalf("expected 3 attachments, got %d", len(parsed.attachments))
+	}
+
+	pdf := parsed.attachments[0]
+	if pdf.Name != "report.pdf" || pdf.ContentType != "application/pdf" || string(pdf.Data) != "%PDF-1.4\n" {
+		t.Errorf("unexpected attachment %q %q %q", pdf.Name, pdf.ContentType, pdf.Data)
+	}
+
+	names := map[string]bool{}
+	for i, expected := range []string{"report.pdf", "data.csv", "data-1.csv"} {
+		if name := uniqueName(names, parsed.attachments[i].Name); name != expected {
+			t.Errorf("expected name %q, got %q", expected, name)
+		}
+	}
+}
+
+func TestParseMessageBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{
+			name: "no content type",
+			raw: `From: sender@example.com
+Subject: Hello
+
+Hello there.
+`,
+			expected: "Hello there.\r\n",
+		},
+		{
+			name: "single part quoted-printable latin-1",
+			raw: `From: sender@example.com
+Content-Type: text/plain; charset=iso-8859-1
+Content-Transfer-Encoding: quoted-printable
+
+Caf=E9 au lait`,
+			expected: "Café au lait",
+		},
+		{
+			name: "html only",
+			raw: `From: sender@example.com
+Content-Type: multipart/mixed; boundary="outer"
+
+--outer
+Content-Type: text/html; charset=utf-8
+
+<p>Hello <b>there</b></p>
+--outer--
+`,
+			expected: "Hello *there*",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, err := mail.ReadMessage(strings.NewReader(strings.ReplaceAll(tt.raw, "\n", "\r\n")))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			parsed, err := parseMessage(message)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			body, err := parsed.body()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if body != tt.expected {
+				t.Errorf("expected body %q, got %q", tt.expected, body)
+			}
+		})
+	}
+}
+
+func TestDecodeHeader(t *testing.T) {
+	if subject := decodeHeader("=?iso-8859-1?q?Caf=E9?= menu"); subject != "Café menu" {
+		t.Errorf("unexpected subject %q", subject)
+	}
+}
+
+func TestAttachmentNameEncodedWord(t *testing.T) {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `attachment; filename="=?UTF-8?B?Li4vcmFwcG9ydC5wZGY=?="`)
+	if name := attachmentName(header, nil); name != "rapport.pdf" {
+		t.Errorf("unexpected name %q", name)
+	}
+}
@@ -0,0 +1,78 @@
+package smtp
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// maxRateLimiters is how many senders are tracked before the limiters of idle senders are dropped.
+const maxRateLimiters = 10_000
+
+// senderRateLimiters keeps a token bucket of messages per minute for each sender IP address.
+type senderRateLimiters struct {
+	lock      sync.Mutex
+	perMinute int
+	limiters  map[string]*senderRateLimiter
+}
+
+type senderRateLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newSenderRateLimiters(perMinute int) *senderRateLimiters {
+	return &senderRateLimiters{
+		perMinute: perMinute,
+		limiters:  map[string]*senderRateLimiter{},
+	}
+}
+
+// delay returns how long until the sender can send another message, or zero if the message is allowed now.
+func (s *senderRateLimiters) delay(sender string, now time.Time) time.Duration {
+	if s == nil || s.perMinute <= 0 {
+		return 0
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.limiters) >= maxRateLimiters {
+		for key, l := range s.limiters {
+			// A limiter that has been idle for a minute is full again, so it is the same as a new one.
+			if now.Sub(l.lastSeen) > time.Minute {
+				delete(s.limiters, key)
+			}
+		}
+	}
+
+	l := s.limiters[sender]
+	if l == nil {
+		l = &senderRateLimiter{
+			limiter: rate.NewLimiter(rate.Every(time.Minute/time.Duration(s.perMinute)), s.perMinute),
+		}
+		s.limiters[sender] = l
+	}
+	l.lastSeen = now
+
+	reservation := l.limiter.ReserveN(now, 1)
+	if d := reservation.DelayFrom(now); d > 0 {
+		reservation.CancelAt(now)
+		return d
+	}
+	return 0
+}
+
+// limited returns whether the sender has no messages left to send now. Unlike delay, it doesn't use up a message.
+func (s *senderRateLimiters) limited(sender string, now time.Time) bool {
+	if s == nil || s.perMinute <= 0 {
+		return false
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	l := s.limiters[sender]
+	return l != nil && l.limiter.TokensAt(now) < 1
+}
@@ -0,0 +1,223 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	netsmtp "net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	replyMessageIDPrefix = "obot."
+	// relayTimeout is how long sending one email through the relay can take.
+	relayTimeout = time.Minute
+)
+
+// Relay is the outbound SMTP server that email replies and notifications are sent through.
+type Relay struct {
+	// Address is the host:port of the SMTP server.
+	Address  string
+	Username string
+	Password string
+	// From is the sender address of email that is not a reply, such as approval requests.
+	From string
+}
+
+// NewRelay returns a relay for the address, or nil if the address is empty.
+func NewRelay(address, username, password, from string) *Relay {
+	if address == "" {
+		return nil
+	}
+	return &Relay{
+		Address:  address,
+		Username: username,
+		Password: password,
+		From:     from,
+	}
+}
+
+// Send sends the message through the relay. STARTTLS is used if the relay supports it. The whole exchange with the
+// relay must finish within relayTimeout or before the context is done.
+func (r *Relay) Send(ctx context.Context, from string, to []string, message []byte) error {
+	host, _, err := net.SplitHostPort(r.Address)
+	if err != nil {
+		return fmt.Errorf("invalid relay address %q: %w", r.Address, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, relayTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", r.Address)
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	c, err := netsmtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if r.Username != "" {
+		if err := c.Auth(netsmtp.PlainAuth("", r.Username, r.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, addr := range to {
+		if err := c.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// IsPermanentError returns true if the relay rejected the email with a permanent (5xx) reply, so sending it again
+// won't help. Other errors, such as a relay that can't be reached, are worth retrying.
+func IsPermanentError(err error) bool {
+	var smtpErr *textproto.Error
+	return errors.As(err, &smtpErr) && smtpErr.Code >= 500
+}
+
+// Reply is an email sent in reply to a received email.
+type Reply struct {
+	From       string
+	To         string
+	Subject    string
+	MessageID  string
+	InReplyTo  string
+	References []string
+	Body       string
+}
+
+// Bytes returns the reply as a message with a quoted-printable text/plain body.
+func (r Reply) Bytes() ([]byte, error) {
+	subject := r.Subject
+	if !strings.HasPrefix(strings.ToLower(subject), "re:") {
+		subject = "Re: " + subject
+	}
+
+	return Message{
+		From:       r.From,
+		To:         r.To,
+		Subject:    subject,
+		MessageID:  r.MessageID,
+		InReplyTo:  r.InReplyTo,
+		References: r.References,
+		Body:       r.Body,
+	}.Bytes()
+}
+
+// Message is an email with a text/plain body.
+type Message struct {
+	From       string
+	To         string
+	Subject    string
+	MessageID  string
+	InReplyTo  string
+	References []string
+	Body       string
+}
+
+// Bytes returns the message with a quoted-printable text/plain body.
+func (m Message) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		if value != "" {
+			fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+		}
+	}
+	header("From", m.From)
+	header("To", m.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", m.MessageID)
+	header("In-Reply-To", m.InReplyTo)
+	header("References", strings.Join(m.References, " "))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(m.Body)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ReplyMessageID returns the Message-ID of the reply for a generation of a workflow execution. The execution can be
+// found from the ID when the reply is replied to.
+func ReplyMessageID(workflowExecutionName string, generation int64, from string) string {
+	_, domain, _ := strings.Cut(from, "@")
+	return fmt.Sprintf("<%s%s.%d@%s>", replyMessageIDPrefix, workflowExecutionName, generation, domain)
+}
+
+// workflowExecutionFromMessageID returns the workflow execution name from the Message-ID of a reply sent by this
+// server.
+func workflowExecutionFromMessageID(messageID, hostname string) (string, bool) {
+	id, ok := strings.CutSuffix(strings.Trim(messageID, "<>"), "@"+hostname)
+	if !ok {
+		return "", false
+	}
+	id, ok = strings.CutPrefix(id, replyMessageIDPrefix)
+	if !ok {
+		return "", false
+	}
+	name, generation, ok := strings.Cut(id, ".")
+	if !ok {
+		return "", false
+	}
+	if _, err := strconv.ParseInt(generation, 10, 64); err != nil {
+		return "", false
+	}
+	return name, true
+}
+
+// messageIDs returns the Message-IDs in an In-Reply-To or References header.
+func messageIDs(header string) []string {
+	return strings.Fields(header)
+}
+
+// replyAddress returns the address that replies to the message are sent to. Reply-To is ignored because, unlike the
+// From header, it is not covered by SPF, DKIM and DMARC.
+func replyAddress(message *mail.Message) (*mail.Address, error) {
+	return mail.ParseAddress(message.Header.Get("From"))
+}
@@ -0,0 +1,76 @@
+package smtp
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
+)
+
+func TestReplyMessageID(t *testing.T) {
+	id := ReplyMessageID("we1abc", 3, "default.triage@mail.example.com")
+	if id != "<obot.we1abc.3@mail.example.com>" {
+		t.Fatalf("unexpected message ID %q", id)
+	}
+
+	if name, ok := workflowExecutionFromMessageID(id, "mail.example.com"); !ok || name != "we1abc" {
+		t.Errorf("expected workflow execution we1abc, got %q", name)
+	}
+
+	for _, id := range []string{
+		"<obot.we1abc.3@other.example.com>",
+		"<we1abc.3@mail.example.com>",
+		"<obot.we1abc.x@mail.example.com>",
+		"<CAF=abc@mail.gmail.com>",
+	} {
+		if name, ok := workflowExecutionFromMessageID(id, "mail.example.com"); ok {
+			t.Errorf("expected %q to not be a reply, got %q", id, name)
+		}
+	}
+}
+
+func TestNewEmailReplyOnlyToVerifiedSender(t *testing.T) {
+	message, err := mail.ReadMessage(strings.NewReader("From: Sender <sender@example.com>\r\nReply-To: other@attacker.example\r\nSubject: Report\r\nMessage-Id: <m1@example.com>\r\n\r\nHello\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		receiver      = &mail.Address{Address: "default.triage@mail.example.com"}
+		email         = v1.EmailReceiver{}
+		authenticated = authenticationResults{Authenticated: true}
+	)
+
+	reply, err := newEmailReply(email, receiver, message, authenticated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply == nil || reply.To != `"Sender" <sender@example.com>` {
+		t.Fatalf("expected a reply to the From address, got %+v", reply)
+	}
+
+	if reply, _ := newEmailReply(email, receiver, message, authenticationResults{}); reply != nil {
+		t.Errorf("expected no reply for an unauthenticated email, got %+v", reply)
+	}
+
+	email.Spec.AllowedSenders = []string{"*@other.example.com"}
+	if reply, _ := newEmailReply(email, receiver, message, authenticated); reply != nil {
+		t.Errorf("expected no reply to a sender that is not allowed, got %+v", reply)
+	}
+}
+
+func TestIsPermanentError(t *testing.T) {
+	if !IsPermanentError(fmt.Errorf("send: %w", &textproto.Error{Code: 550, Msg: "mailbox unavailable"})) {
+		t.Error("expected a 5xx reply to be permanent")
+	}
+	if IsPermanentError(&textproto.Error{Code: 451, Msg: "try again later"}) {
+		t.Error("expected a 4xx reply not to be permanent")
+	}
+	if IsPermanentError(errors.New("connection refused")) {
+		t.Error("expected a connection error not to be permanent")
+	}
+}
@@ -3,68 +3,153 @@ package smtp
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"mime"
-	"mime/multipart"
 	"net"
 	"net/mail"
 	"path"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/gptscript-ai/go-gptscript"
 	"github.com/mhale/smtpd"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/alias"
+	"github.com/obot-platform/obot/pkg/invoke"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	"golang.org/x/net/netutil"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var log = logger.Package()
 
+// Options configure the SMTP server.
+type Options struct {
+	// Hostname is the domain of the email receiver addresses.
+	Hostname string
+	// Address is the address to listen on. The default is ":2525".
+	Address string
+	// TLSCertFile and TLSKeyFile enable STARTTLS.
+	TLSCertFile string
+	TLSKeyFile  string
+	// RequireTLS rejects mail from clients that do not use STARTTLS.
+	RequireTLS bool
+	// MaxMessageSize is the largest message accepted, in bytes.
+	MaxMessageSize int
+	// MaxConnections is how many connections are served at once. More connections wait to be accepted.
+	MaxConnections int
+	// MaxConnectionsPerIP is how many connections one IP address can have open at once. More connections are turned
+	// away.
+	MaxConnectionsPerIP int
+	// MaxMessagesPerMinute is how many messages are accepted from one IP address per minute.
+	MaxMessagesPerMinute int
+}
+
 type Server struct {
-	s        smtpd.Server
-	c        kclient.Client
-	ctx      context.Context
-	hostname string
+	s             smtpd.Server
+	c             kclient.WithWatch
+	gptClient     *gptscript.GPTScript
+	invoker       *invoke.Invoker
+	authenticator *authenticator
+	limiters      *senderRateLimiters
+	ctx           context.Context
+	hostname      string
 }
 
-func Start(ctx context.Context, c kclient.Client, hostname string) {
-	s := Server{
+// Start listens for email and serves it until the context is done. An error is returned if the server can't listen.
+func Start(ctx context.Context, c kclient.WithWatch, gptClient *gptscript.GPTScript, invoker *invoke.Invoker, opts Options) error {
+	if opts.Address == "" {
+		opts.Address = ":2525"
+	}
+
+	s := &Server{
 		s: smtpd.Server{
-			Addr: ":2525",
+			Addr:     opts.Address,
+			Appname:  "obot",
+			Hostname: opts.Hostname,
+			MaxSize:  opts.MaxMessageSize,
 		},
-		c:        c,
-		ctx:      ctx,
-		hostname: hostname,
+		c:             c,
+		gptClient:     gptClient,
+		invoker:       invoker,
+		authenticator: newAuthenticator(),
+		limiters:      newSenderRateLimiters(opts.MaxMessagesPerMinute),
+		ctx:           ctx,
+		hostname:      opts.Hostname,
 	}
 	s.s.Handler = s.handler
+
+	if opts.TLSCertFile != "" || opts.TLSKeyFile != "" {
+		if err := s.s.ConfigureTLS(opts.TLSCertFile, opts.TLSKeyFile); err != nil {
+			return fmt.Errorf("failed to load SMTP server TLS certificate: %w", err)
+		}
+		s.s.TLSRequired = opts.RequireTLS
+	} else if opts.RequireTLS {
+		return errors.New("SMTP server requires TLS, but no TLS certificate is configured")
+	}
+
+	ln, err := net.Listen("tcp", opts.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen for SMTP on %s: %w", opts.Address, err)
+	}
+	if opts.MaxConnections > 0 {
+		ln = netutil.LimitListener(ln, opts.MaxConnections)
+	}
+	ln = newConnectionLimitListener(ln, opts.MaxConnectionsPerIP, s.limiters)
+
 	go func() {
-		err := s.s.ListenAndServe()
-		select {
-		case <-ctx.Done():
-		default:
-			log.Fatalf("smtp server shutdown: %v", err)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := s.s.Shutdown(shutdownCtx); err != nil {
+			_ = s.s.Close()
 		}
 	}()
+
+	go func() {
+		// Serve only returns once the listener fails, which is not fatal to the rest of the server.
+		if err := s.s.Serve(ln); err != nil && !errors.Is(err, smtpd.ErrServerClosed) && ctx.Err() == nil {
+			log.Errorf("SMTP server stopped: %v", err)
+		}
+	}()
+
+	log.Infof("SMTP server listening on %s", opts.Address)
+	return nil
 }
 
-func (s *Server) handler(_ net.Addr, from string, to []string, data []byte) error {
+func (s *Server) handler(remoteAddr net.Addr, from string, to []string, data []byte) error {
 	log.Infof("New mail received from %s for %s: length=%d", from, to, len(data))
 
+	if d := s.limiters.delay(remoteIPString(remoteAddr), time.Now()); d > 0 {
+		log.Infof("Deferring mail from %s: rate limit exceeded", remoteAddr)
+		// A 4xx reply makes the sender retry later.
+		return fmt.Errorf("451 4.7.1 Too many messages, try again in %s", d.Round(time.Second))
+	}
+
 	message, err := mail.ReadMessage(bytes.NewReader(data))
 	if err != nil {
-		return fmt.Errorf("read message: %w", err)
+		log.Infof("Rejecting mail from %s: %v", from, err)
+		return errors.New("550 5.6.0 Malformed message")
+	}
+
+	parsed, err := parseMessage(message)
+	if err != nil {
+		log.Infof("Rejecting mail from %s: %v", from, err)
+		return errors.New("550 5.6.0 Malformed message")
 	}
 
-	body, err := getBody(message)
+	body, err := parsed.body()
 	if err != nil {
-		return fmt.Errorf("get body: %w", err)
+		log.Infof("Rejecting mail from %s: %v", from, err)
+		return errors.New("550 5.6.0 Message has no text body")
 	}
 
 	fromAddress, err := mail.ParseAddress(from)
@@ -72,6 +157,12 @@ func (s *Server) handler(_ net.Addr, from string, to []string, data []byte) erro
 		return fmt.Errorf("parse from address: %w", err)
 	}
 
+	var headerFrom string
+	if addr, err := mail.ParseAddress(message.Header.Get("From")); err == nil {
+		headerFrom = addr.Address
+	}
+	authentication := s.authenticator.authenticate(s.ctx, remoteAddr, fromAddress.Address, headerFrom, data)
+
 	for _, to := range to {
 		toAddr, err := mail.ParseAddress(to)
 		if err != nil {
@@ -101,11 +192,28 @@ func (s *Server) handler(_ net.Addr, from string, to []string, data []byte) erro
 			return fmt.Errorf("get email receiver: %w", err)
 		}
 
+		if emailReceiver.Spec.Suspended {
+			log.Infof("Skipping mail for %s: receiver is suspended", toAddr.Address)
+			continue
+		}
+
 		if !matches(fromAddress.Address, emailReceiver) {
 			log.Infof("Skipping mail for %s: sender not allowed", toAddr.Address)
 			continue
 		}
 
+		if emailReceiver.Spec.AuthenticationPolicy == types.EmailAuthenticationPolicyReject {
+			if !authentication.Authenticated {
+				log.Infof("Skipping mail for %s: sender not authenticated (spf=%s, dkim=%s, dmarc=%s)", toAddr.Address,
+					authentication.SPF, authentication.DKIM, authentication.DMARC)
+				continue
+			}
+			if !matches(headerFrom, emailReceiver) {
+				log.Infof("Skipping mail for %s: From header not allowed", toAddr.Address)
+				continue
+			}
+		}
+
 		if len(emailReceiver.Spec.AllowedSenders) > 0 {
 			for _, allowedSender := range emailReceiver.Spec.AllowedSenders {
 				if allowedSender == fromAddress.Address {
@@ -114,7 +222,7 @@ func (s *Server) handler(_ net.Addr, from string, to []string, data []byte) erro
 			}
 		}
 
-		if err := s.dispatchEmail(emailReceiver, body, message); err != nil {
+		if err := s.dispatchEmail(emailReceiver, toAddr, body, parsed.attachments, authentication, message); err != nil {
 			return fmt.Errorf("dispatch email: %w", err)
 		}
 	}
@@ -122,77 +230,75 @@ func (s *Server) handler(_ net.Addr, from string, to []string, data []byte) erro
 	return err
 }
 
-func getBody(message *mail.Message) (string, error) {
-	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
-	if err != nil {
-		return "", err
-	}
-
-	var html string
-	if strings.HasPrefix(mediaType, "multipart/") {
-		mr := multipart.NewReader(message.Body, params["boundary"])
-		for {
-			p, err := mr.NextPart()
-			if err != nil {
-				if errors.Is(err, io.EOF) {
-					// Break and return whatever html is found or an error.
-					break
-				}
-				return "", err
-			}
-			if strings.HasPrefix(p.Header.Get("Content-Type"), "text/plain") {
-				d, err := io.ReadAll(p)
-				if err != nil {
-					return "", err
-				}
-				if p.Header.Get("Content-Transfer-Encoding") == "base64" {
-					d, err = base64.StdEncoding.DecodeString(string(d))
-				}
-				return string(d), err
-			}
-			if strings.HasPrefix(p.Header.Get("Content-Type"), "text/html") {
-				d, err := io.ReadAll(p)
-				if err != nil {
-					return "", err
-				}
-				html = string(d)
-			}
-		}
-	}
-
-	if html != "" {
-		return html, nil
-	}
-
-	return "", fmt.Errorf("failed to find text/plain body: %s", mediaType)
+type attachmentInput struct {
+	Name        string `json:"name"`
+	ContentType string `json:"contentType,omitempty"`
+	Size        int    `json:"size"`
+	// Error is set if the attachment was not written to the workspace.
+	Error string `json:"error,omitempty"`
 }
 
-func (s *Server) dispatchEmail(email v1.EmailReceiver, body string, message *mail.Message) error {
+func (s *Server) dispatchEmail(email v1.EmailReceiver, receiverAddress *mail.Address, body string, attachments []attachment, authentication authenticationResults, message *mail.Message) error {
 	var input struct {
-		Type    string `json:"type"`
-		From    string `json:"from"`
-		To      string `json:"to"`
-		Subject string `json:"subject"`
-		Body    string `json:"body"`
+		Type           string                `json:"type"`
+		From           string                `json:"from"`
+		To             string                `json:"to"`
+		Subject        string                `json:"subject"`
+		Body           string                `json:"body"`
+		Attachments    []attachmentInput     `json:"attachments,omitempty"`
+		Authentication authenticationResults `json:"authentication"`
 	}
 
 	input.Type = "email"
 	input.From = message.Header.Get("From")
 	input.To = message.Header.Get("To")
-	input.Subject = message.Header.Get("Subject")
+	input.Subject = decodeHeader(message.Header.Get("Subject"))
 	input.Body = body
+	input.Authentication = authentication
+
+	var workflow v1.Workflow
+	if err := alias.Get(s.ctx, s.c, &workflow, email.Namespace, email.Spec.Workflow); err != nil {
+		return err
+	}
+
+	var accepted []attachment
+	if len(attachments) > 0 {
+		accepted, input.Attachments = attachmentsWithinLimits(email, attachments)
+	}
 
 	inputJSON, err := json.Marshal(input)
 	if err != nil {
 		return fmt.Errorf("marshal input: %w", err)
 	}
 
-	var workflow v1.Workflow
-	if err := alias.Get(s.ctx, s.c, &workflow, email.Namespace, email.Spec.Workflow); err != nil {
-		return err
+	var reply *v1.EmailReply
+	if email.Spec.ReplyWithOutput {
+		reply, err = newEmailReply(email, receiverAddress, message, authentication)
+		if err != nil {
+			return fmt.Errorf("reply address: %w", err)
+		}
+	}
+
+	// Only a verified sender gets the output or can continue an execution, so a forged message can't have the output
+	// sent elsewhere or read the thread of another sender.
+	if reply != nil {
+		wfe, err := s.findWorkflowExecution(email, message, reply)
+		if err != nil {
+			return err
+		} else if wfe != nil {
+			return s.continueWorkflowExecution(wfe, &workflow, string(inputJSON), accepted, reply, message)
+		}
+	}
+
+	var workspace *v1.Workspace
+	if len(accepted) > 0 {
+		workspace, err = s.newAttachmentsWorkspace(&workflow, accepted)
+		if err != nil {
+			return fmt.Errorf("write attachments: %w", err)
+		}
 	}
 
-	return s.c.Create(s.ctx, &v1.WorkflowExecution{
+	wfe := &v1.WorkflowExecution{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: system.WorkflowExecutionPrefix,
 			Namespace:    workflow.Namespace,
@@ -202,8 +308,141 @@ func (s *Server) dispatchEmail(email v1.EmailReceiver, body string, message *mai
 			EmailReceiverName: email.Name,
 			ThreadName:        workflow.Spec.ThreadName,
 			Input:             string(inputJSON),
+			EmailReply:        reply,
 		},
+	}
+	if reply != nil {
+		wfe.Spec.EmailMessageID = message.Header.Get("Message-Id")
+	}
+	if workspace != nil {
+		wfe.Spec.WorkspaceName = workspace.Name
+	}
+
+	if err := s.c.Create(s.ctx, wfe); err != nil {
+		if workspace != nil {
+			_ = s.c.Delete(s.ctx, workspace)
+		}
+		return err
+	}
+
+	if workspace != nil {
+		// Now that the execution exists, the attachments workspace is cleaned up with it instead of with the workflow.
+		workspace.Spec.WorkflowExecutionName = wfe.Name
+		return s.c.Update(s.ctx, workspace)
+	}
+
+	return nil
+}
+
+// findWorkflowExecution returns the workflow execution of the receiver that an email is a reply to, or nil if it
+// isn't a reply to an email the receiver has seen. Only an execution that replies to the same sender is returned.
+func (s *Server) findWorkflowExecution(email v1.EmailReceiver, message *mail.Message, reply *v1.EmailReply) (*v1.WorkflowExecution, error) {
+	ids := messageIDs(message.Header.Get("In-Reply-To"))
+	references := messageIDs(message.Header.Get("References"))
+	// The most recent references are last.
+	slices.Reverse(references)
+	ids = append(ids, references...)
+
+	for _, id := range ids {
+		var candidates []v1.WorkflowExecution
+		if name, ok := workflowExecutionFromMessageID(id, s.hostname); ok {
+			var wfe v1.WorkflowExecution
+			if err := s.c.Get(s.ctx, router.Key(email.Namespace, name), &wfe); apierror.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			candidates = append(candidates, wfe)
+		} else {
+			var wfes v1.WorkflowExecutionList
+			if err := s.c.List(s.ctx, &wfes, kclient.InNamespace(email.Namespace), kclient.MatchingFields{
+				"spec.emailMessageID": id,
+			}); err != nil {
+				return nil, err
+			}
+			candidates = wfes.Items
+		}
+
+		for _, wfe := range candidates {
+			if wfe.Spec.EmailReceiverName == email.Name && wfe.Status.ThreadName != "" && wfe.DeletionTimestamp.IsZero() &&
+				wfe.Spec.EmailReply != nil && sameAddress(wfe.Spec.EmailReply.To, reply.To) {
+				return &wfe, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// continueWorkflowExecution runs the workflow again in the thread of the execution with the input of a reply.
+func (s *Server) continueWorkflowExecution(wfe *v1.WorkflowExecution, workflow *v1.Workflow, input string, attachments []attachment, reply *v1.EmailReply, message *mail.Message) error {
+	if len(attachments) > 0 {
+		var thread v1.Thread
+		if err := s.c.Get(s.ctx, router.Key(wfe.Namespace, wfe.Status.ThreadName), &thread); err != nil {
+			return err
+		}
+		if thread.Status.WorkspaceID == "" {
+			return fmt.Errorf("thread %s has no workspace", thread.Name)
+		}
+		if err := s.writeAttachments(thread.Status.WorkspaceID, attachments); err != nil {
+			return fmt.Errorf("write attachments: %w", err)
+		}
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := s.c.Get(s.ctx, kclient.ObjectKeyFromObject(wfe), wfe); err != nil {
+			return err
+		}
+		wfe.Spec.EmailMessageID = message.Header.Get("Message-Id")
+		wfe.Spec.EmailReply = reply
+		return s.c.Update(s.ctx, wfe)
+	}); err != nil {
+		return err
+	}
+
+	resp, err := s.invoker.Workflow(s.ctx, s.c, workflow, input, invoke.WorkflowOptions{
+		ThreadName: wfe.Status.ThreadName,
 	})
+	if err != nil {
+		return err
+	}
+	resp.Close()
+	return nil
+}
+
+// newEmailReply returns where the output of the workflow is sent for the message. The output is only sent to the
+// address in the From header, and only if that address is authenticated and is an allowed sender of the receiver.
+// Otherwise, nil is returned.
+func newEmailReply(email v1.EmailReceiver, receiverAddress *mail.Address, message *mail.Message, authentication authenticationResults) (*v1.EmailReply, error) {
+	to, err := replyAddress(message)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authentication.Authenticated || !matches(to.Address, email) {
+		log.Infof("Not replying to %s for %s: sender is not authenticated or not allowed", to.Address, receiverAddress.Address)
+		return nil, nil
+	}
+
+	messageID := message.Header.Get("Message-Id")
+	references := messageIDs(message.Header.Get("References"))
+	if messageID != "" && !slices.Contains(references, messageID) {
+		references = append(references, messageID)
+	}
+
+	return &v1.EmailReply{
+		From:       receiverAddress.Address,
+		To:         to.String(),
+		Subject:    decodeHeader(message.Header.Get("Subject")),
+		InReplyTo:  messageID,
+		References: references,
+	}, nil
+}
+
+func sameAddress(a, b string) bool {
+	addrA, errA := mail.ParseAddress(a)
+	addrB, errB := mail.ParseAddress(b)
+	return errA == nil && errB == nil && strings.EqualFold(addrA.Address, addrB.Address)
 }
 
 func matches(address string, email v1.EmailReceiver) bool {
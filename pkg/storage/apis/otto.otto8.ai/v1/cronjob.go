@@ -63,6 +63,8 @@ type CronJobSpec struct {
 }
 
 type CronJobStatus struct {
+	// LastScheduleTime is the scheduled time of the last run that was started or skipped.
+	LastScheduleTime           *metav1.Time `json:"lastScheduleTime,omitempty"`
 	LastRunStartedAt           *metav1.Time `json:"lastRunStartedAt,omitempty"`
 	LastSuccessfulRunCompleted *metav1.Time `json:"lastSuccessfulRunCompleted,omitempty"`
 }
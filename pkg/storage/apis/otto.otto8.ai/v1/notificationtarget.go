@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ fields.Fields = (*NotificationDelivery)(nil)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NotificationTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationTargetSpec   `json:"spec,omitempty"`
+	Status NotificationTargetStatus `json:"status,omitempty"`
+}
+
+func (*NotificationTarget) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"URL", "Spec.URL"},
+		{"Events", "Spec.Events"},
+		{"Last Delivery", "{{ago .Status.LastDeliveryAt}}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+// WantsEvent returns true if the event should be sent to the target.
+func (in *NotificationTarget) WantsEvent(event types.NotificationEvent) bool {
+	return len(in.Spec.Events) == 0 || slices.Contains(in.Spec.Events, event)
+}
+
+type NotificationTargetSpec struct {
+	types.NotificationTargetManifest `json:",inline"`
+}
+
+type NotificationTargetStatus struct {
+	LastDeliveryAt    *metav1.Time `json:"lastDeliveryAt,omitempty"`
+	LastDeliveryError string       `json:"lastDeliveryError,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NotificationTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []NotificationTarget `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationDelivery is a notification of an event that is sent to a NotificationTarget until it succeeds or runs
+// out of attempts.
+type NotificationDelivery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationDeliverySpec   `json:"spec,omitempty"`
+	Status NotificationDeliveryStatus `json:"status,omitempty"`
+}
+
+func (in *NotificationDelivery) Has(field string) (exists bool) {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *NotificationDelivery) Get(field string) (value string) {
+	switch field {
+	case "spec.notificationTargetName":
+		return in.Spec.NotificationTargetName
+	}
+	return ""
+}
+
+func (in *NotificationDelivery) FieldNames() []string {
+	return []string{"spec.notificationTargetName"}
+}
+
+func (*NotificationDelivery) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Target", "Spec.NotificationTargetName"},
+		{"Event", "Spec.Event"},
+		{"Source", "Spec.SourceName"},
+		{"Attempts", "Status.Attempts"},
+		{"Delivered", "{{ago .Status.DeliveredAt}}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (in *NotificationDelivery) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: new(NotificationTarget), Name: in.Spec.NotificationTargetName},
+	}
+}
+
+type NotificationDeliverySpec struct {
+	NotificationTargetName string                  `json:"notificationTargetName,omitempty"`
+	Event                  types.NotificationEvent `json:"event,omitempty"`
+	// SourceName is the name of the workflow execution or run the event is for.
+	SourceName string `json:"sourceName,omitempty"`
+	// Payload is the JSON body of the notification. It is built once so that every attempt sends the same body.
+	Payload []byte `json:"payload,omitempty"`
+}
+
+type NotificationDeliveryStatus struct {
+	Attempts      int          `json:"attempts,omitempty"`
+	LastAttemptAt *metav1.Time `json:"lastAttemptAt,omitempty"`
+	DeliveredAt   *metav1.Time `json:"deliveredAt,omitempty"`
+	StatusCode    int          `json:"statusCode,omitempty"`
+	Error         string       `json:"error,omitempty"`
+	// Failed is true once the notification is not going to be retried.
+	Failed bool `json:"failed,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NotificationDeliveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []NotificationDelivery `json:"items"`
+}
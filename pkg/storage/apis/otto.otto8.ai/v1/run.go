@@ -77,6 +77,8 @@ type RunSpec struct {
 	CredentialContextIDs  []string                `json:"credentialContextIDs,omitempty"`
 	DefaultModel          string                  `json:"defaultModel,omitempty"`
 	Timeout               metav1.Duration         `json:"timeout,omitempty"`
+	// Abort cancels the run without aborting the other runs in its thread.
+	Abort bool `json:"abort,omitempty"`
 }
 
 func (in *Run) DeleteRefs() []Ref {
@@ -94,6 +96,10 @@ type RunStatus struct {
 	EndTime    metav1.Time              `json:"endTime,omitempty"`
 	Error      string                   `json:"error,omitempty"`
 	SubCall    *SubCall                 `json:"subCall,omitempty"`
+	TokenUsage types.TokenUsage         `json:"tokenUsage,omitempty"`
+	// NeedsAuth is true once the run has asked the user to log in to a tool, and AuthURL is the login URL if there is one.
+	NeedsAuth bool   `json:"needsAuth,omitempty"`
+	AuthURL   string `json:"authURL,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
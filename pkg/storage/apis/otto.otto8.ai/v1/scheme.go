@@ -53,6 +53,12 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&WorkspaceList{},
 		&Webhook{},
 		&WebhookList{},
+		&WebhookDelivery{},
+		&WebhookDeliveryList{},
+		&NotificationTarget{},
+		&NotificationTargetList{},
+		&NotificationDelivery{},
+		&NotificationDeliveryList{},
 		&CronJob{},
 		&CronJobList{},
 		&OAuthApp{},
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"slices"
+	"time"
 
 	"github.com/obot-platform/nah/pkg/fields"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -9,6 +10,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DefaultIdempotencyWindow is how long a delivery ID is remembered if the webhook does not set a window.
+const DefaultIdempotencyWindow = 24 * time.Hour
+
 var (
 	_ Aliasable     = (*Webhook)(nil)
 	_ fields.Fields = (*Webhook)(nil)
@@ -99,3 +103,11 @@ type WebhookList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Webhook `json:"items"`
 }
+
+// GetIdempotencyWindow returns how long the ID of a delivery to the webhook is remembered.
+func (in *Webhook) GetIdempotencyWindow() (time.Duration, error) {
+	if in.Spec.IdempotencyWindow == "" {
+		return DefaultIdempotencyWindow, nil
+	}
+	return time.ParseDuration(in.Spec.IdempotencyWindow)
+}
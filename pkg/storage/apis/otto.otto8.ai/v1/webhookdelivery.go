@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ fields.Fields = (*WebhookDelivery)(nil)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WebhookDelivery is a record of a request received by a webhook.
+type WebhookDelivery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebhookDeliverySpec `json:"spec,omitempty"`
+	Status EmptyStatus         `json:"status,omitempty"`
+}
+
+func (in *WebhookDelivery) Has(field string) (exists bool) {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *WebhookDelivery) Get(field string) (value string) {
+	switch field {
+	case "spec.webhookName":
+		return in.Spec.WebhookName
+	case "spec.idempotencyKey":
+		return in.Spec.IdempotencyKey
+	}
+	return ""
+}
+
+func (in *WebhookDelivery) FieldNames() []string {
+	return []string{"spec.webhookName", "spec.idempotencyKey"}
+}
+
+func (*WebhookDelivery) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Webhook", "Spec.WebhookName"},
+		{"Result", "Spec.Result"},
+		{"Status", "Spec.StatusCode"},
+		{"WFE", "Spec.WorkflowExecutionName"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (in *WebhookDelivery) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: new(Webhook), Name: in.Spec.WebhookName},
+	}
+}
+
+type WebhookDeliverySpec struct {
+	WebhookName string `json:"webhookName,omitempty"`
+	// Headers are the headers of the request selected by the webhook.
+	Headers    map[string]string `json:"headers,omitempty"`
+	BodySize   int               `json:"bodySize,omitempty"`
+	BodySHA256 string            `json:"bodySHA256,omitempty"`
+	// Body is the gzip compressed body of the request. It is not kept for large bodies, and those deliveries can not be
+	// replayed.
+	Body                  []byte                      `json:"body,omitempty"`
+	Result                types.WebhookDeliveryResult `json:"result,omitempty"`
+	StatusCode            int                         `json:"statusCode,omitempty"`
+	Error                 string                      `json:"error,omitempty"`
+	WorkflowExecutionName string                      `json:"workflowExecutionName,omitempty"`
+	// IdempotencyKey is the unique ID of the delivery from the webhook's idempotency header or field.
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+	// ReplayOf is the name of the delivery this delivery replayed.
+	ReplayOf string `json:"replayOf,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type WebhookDeliveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []WebhookDelivery `json:"items"`
+}
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"encoding/json"
+
 	"github.com/obot-platform/nah/pkg/fields"
 	"github.com/obot-platform/obot/apiclient/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,6 +39,8 @@ func (in *WorkflowExecution) Get(field string) string {
 			return in.Spec.WorkflowName
 		case "spec.parentRunName":
 			return in.Spec.ParentRunName
+		case "spec.emailMessageID":
+			return in.Spec.EmailMessageID
 		}
 	}
 
@@ -50,6 +54,7 @@ func (in *WorkflowExecution) FieldNames() []string {
 		"spec.cronJobName",
 		"spec.workflowName",
 		"spec.parentRunName",
+		"spec.emailMessageID",
 	}
 }
 
@@ -79,6 +84,20 @@ type WorkflowExecutionSpec struct {
 	WorkflowGeneration    int64  `json:"workflowGeneration,omitempty"`
 	RunUntilStep          string `json:"runUntilStep,omitempty"`
 	ThreadCredentialScope *bool  `json:"threadCredentialScope,omitempty"`
+	// EmailMessageID is the Message-ID of the latest email that started or continued the execution.
+	EmailMessageID string `json:"emailMessageID,omitempty"`
+	// EmailReply is set if the output of the execution is sent back to the sender of the email.
+	EmailReply *EmailReply `json:"emailReply,omitempty"`
+}
+
+type EmailReply struct {
+	// From is the address of the email receiver the email was sent to.
+	From    string `json:"from,omitempty"`
+	To      string `json:"to,omitempty"`
+	Subject string `json:"subject,omitempty"`
+	// InReplyTo is the Message-ID of the email that is replied to.
+	InReplyTo  string   `json:"inReplyTo,omitempty"`
+	References []string `json:"references,omitempty"`
 }
 
 func (in *WorkflowExecution) DeleteRefs() []Ref {
@@ -89,13 +108,25 @@ func (in *WorkflowExecution) DeleteRefs() []Ref {
 }
 
 type WorkflowExecutionStatus struct {
-	State              types.WorkflowState     `json:"state,omitempty"`
-	Output             string                  `json:"output,omitempty"`
-	Error              string                  `json:"error,omitempty"`
-	ThreadName         string                  `json:"threadName,omitempty"`
-	WorkflowManifest   *types.WorkflowManifest `json:"workflowManifest,omitempty"`
-	EndTime            *metav1.Time            `json:"endTime,omitempty"`
-	WorkflowGeneration int64                   `json:"workflowGeneration,omitempty"`
+	State  types.WorkflowState `json:"state,omitempty"`
+	Output string              `json:"output,omitempty"`
+	// LastRunName is the run that produced the output, which has the complete output if Output is truncated.
+	LastRunName string `json:"lastRunName,omitempty"`
+	// OutputObject is the parsed output of the workflow if the workflow declares an output schema.
+	OutputObject     json.RawMessage         `json:"outputObject,omitempty"`
+	Error            string                  `json:"error,omitempty"`
+	ThreadName       string                  `json:"threadName,omitempty"`
+	WorkflowManifest *types.WorkflowManifest `json:"workflowManifest,omitempty"`
+	// StartTime is when the current generation of the workflow started running, which is what the timeout is measured from.
+	StartTime          *metav1.Time `json:"startTime,omitempty"`
+	EndTime            *metav1.Time `json:"endTime,omitempty"`
+	WorkflowGeneration int64        `json:"workflowGeneration,omitempty"`
+	// EmailReplyGeneration is the workflow generation the output was last emailed for, or gave up being emailed for.
+	EmailReplyGeneration int64  `json:"emailReplyGeneration,omitempty"`
+	EmailReplyError      string `json:"emailReplyError,omitempty"`
+	// EmailReplyAttempts is how many times sending the reply for the current generation has failed.
+	EmailReplyAttempts      int          `json:"emailReplyAttempts,omitempty"`
+	EmailReplyLastAttemptAt *metav1.Time `json:"emailReplyLastAttemptAt,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
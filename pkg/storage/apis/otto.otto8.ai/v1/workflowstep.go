@@ -49,6 +49,7 @@ func (in *WorkflowStep) GetColumns() [][]string {
 		{"After", "Spec.AfterWorkflowStepName"},
 		{"Runs", "{{ .Status.RunNames | arrayNoSpace }}"},
 		{"LastRun", "Status.LastRunName"},
+		{"Retries", "Status.RetryCount"},
 		{"StepID", "Spec.Step.ID"},
 		{"WFE", "Spec.WorkflowExecutionName"},
 		{"Created", "{{ago .CreationTimestamp}}"},
@@ -82,6 +83,41 @@ type WorkflowStepStatus struct {
 	ThreadName         string              `json:"threadName,omitempty"`
 	RunNames           []string            `json:"runNames,omitempty"`
 	LastRunName        string              `json:"lastRunName,omitempty"`
+	// RetryCount is the number of failed attempts that were retried.
+	RetryCount int `json:"retryCount,omitempty"`
+	// AttemptRunOffset is the index in RunNames of the first run of the current attempt. The runs of retried
+	// attempts are kept before it.
+	AttemptRunOffset int `json:"attemptRunOffset,omitempty"`
+	// OutputSchemaCorrections is the number of runs of the current attempt that asked the model to correct output
+	// that did not match the output schema.
+	OutputSchemaCorrections int `json:"outputSchemaCorrections,omitempty"`
+	// Approval is set for approval steps once they are waiting for a decision.
+	Approval *StepApproval `json:"approval,omitempty"`
+	// StartTime is when the step started running, which is what the step timeout is measured from.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+type ApprovalDecision string
+
+const (
+	ApprovalDecisionApproved ApprovalDecision = "Approved"
+	ApprovalDecisionRejected ApprovalDecision = "Rejected"
+)
+
+type StepApproval struct {
+	// Token authorizes a decision made through the approval link instead of the authenticated API.
+	Token    string           `json:"token,omitempty"`
+	Decision ApprovalDecision `json:"decision,omitempty"`
+	Approver string           `json:"approver,omitempty"`
+	Comment  string           `json:"comment,omitempty"`
+	Time     metav1.Time      `json:"time,omitempty"`
+	// NotifiedApprovers are the approvers the approval link was sent to. The others are retried with backoff.
+	NotifiedApprovers []string `json:"notifiedApprovers,omitempty"`
+	// NotificationAttempts is how many times sending the approval link to the approvers that are left has been tried.
+	NotificationAttempts int          `json:"notificationAttempts,omitempty"`
+	LastNotificationAt   *metav1.Time `json:"lastNotificationAt,omitempty"`
+	// NotificationError is set if the approval link could not be sent to some of the approvers.
+	NotificationError string `json:"notificationError,omitempty"`
 }
 
 func (in WorkflowStepStatus) FirstRun() string {
@@ -91,6 +127,14 @@ func (in WorkflowStepStatus) FirstRun() string {
 	return in.LastRunName
 }
 
+// AttemptRunNames returns the run names of the current attempt, skipping the runs of retried attempts.
+func (in WorkflowStepStatus) AttemptRunNames() []string {
+	if in.AttemptRunOffset >= len(in.RunNames) {
+		return nil
+	}
+	return in.RunNames[in.AttemptRunOffset:]
+}
+
 func (in WorkflowStepStatus) HasRunsSet() bool {
 	return in.LastRunName != "" || len(in.RunNames) > 0
 }
@@ -62,6 +62,7 @@ func (in *Workspace) DeleteRefs() []Ref {
 		{ObjType: new(Workflow), Name: in.Spec.WorkflowName},
 		{ObjType: new(KnowledgeSet), Name: in.Spec.KnowledgeSetName},
 		{ObjType: new(KnowledgeSource), Name: in.Spec.KnowledgeSourceName},
+		{ObjType: new(WorkflowExecution), Name: in.Spec.WorkflowExecutionName},
 	}
 }
 
@@ -72,6 +73,9 @@ type WorkspaceSpec struct {
 	KnowledgeSetName    string   `json:"knowledgeSetName,omitempty"`
 	KnowledgeSourceName string   `json:"knowledgeSourceName,omitempty"`
 	FromWorkspaceNames  []string `json:"fromWorkspaceNames,omitempty"`
+	// WorkflowExecutionName is set for a workspace that is created for the input of a single workflow execution, such
+	// as the attachments of an email.
+	WorkflowExecutionName string `json:"workflowExecutionName,omitempty"`
 }
 
 type WorkspaceStatus struct {
@@ -5,6 +5,8 @@
 package v1
 
 import (
+	"encoding/json"
+
 	"github.com/obot-platform/obot/apiclient/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -284,6 +286,10 @@ func (in *CronJobSpec) DeepCopy() *CronJobSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronJobStatus) DeepCopyInto(out *CronJobStatus) {
 	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
 	if in.LastRunStartedAt != nil {
 		in, out := &in.LastRunStartedAt, &out.LastRunStartedAt
 		*out = (*in).DeepCopy()
@@ -484,6 +490,26 @@ func (in *EmailReceiverStatus) DeepCopy() *EmailReceiverStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailReply) DeepCopyInto(out *EmailReply) {
+	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailReply.
+func (in *EmailReply) DeepCopy() *EmailReply {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailReply)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmptyStatus) DeepCopyInto(out *EmptyStatus) {
 	*out = *in
@@ -987,6 +1013,202 @@ func (in *ModelStatus) DeepCopy() *ModelStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDelivery) DeepCopyInto(out *NotificationDelivery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDelivery.
+func (in *NotificationDelivery) DeepCopy() *NotificationDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationDelivery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDeliveryList) DeepCopyInto(out *NotificationDeliveryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDeliveryList.
+func (in *NotificationDeliveryList) DeepCopy() *NotificationDeliveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDeliveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationDeliveryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDeliverySpec) DeepCopyInto(out *NotificationDeliverySpec) {
+	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDeliverySpec.
+func (in *NotificationDeliverySpec) DeepCopy() *NotificationDeliverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDeliverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDeliveryStatus) DeepCopyInto(out *NotificationDeliveryStatus) {
+	*out = *in
+	if in.LastAttemptAt != nil {
+		in, out := &in.LastAttemptAt, &out.LastAttemptAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DeliveredAt != nil {
+		in, out := &in.DeliveredAt, &out.DeliveredAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDeliveryStatus.
+func (in *NotificationDeliveryStatus) DeepCopy() *NotificationDeliveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDeliveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTarget) DeepCopyInto(out *NotificationTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTarget.
+func (in *NotificationTarget) DeepCopy() *NotificationTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTargetList) DeepCopyInto(out *NotificationTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTargetList.
+func (in *NotificationTargetList) DeepCopy() *NotificationTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTargetSpec) DeepCopyInto(out *NotificationTargetSpec) {
+	*out = *in
+	in.NotificationTargetManifest.DeepCopyInto(&out.NotificationTargetManifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTargetSpec.
+func (in *NotificationTargetSpec) DeepCopy() *NotificationTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTargetStatus) DeepCopyInto(out *NotificationTargetStatus) {
+	*out = *in
+	if in.LastDeliveryAt != nil {
+		in, out := &in.LastDeliveryAt, &out.LastDeliveryAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTargetStatus.
+func (in *NotificationTargetStatus) DeepCopy() *NotificationTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuthApp) DeepCopyInto(out *OAuthApp) {
 	*out = *in
@@ -1352,6 +1574,7 @@ func (in *RunStatus) DeepCopyInto(out *RunStatus) {
 		*out = new(SubCall)
 		**out = **in
 	}
+	out.TokenUsage = in.TokenUsage
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunStatus.
@@ -1364,6 +1587,31 @@ func (in *RunStatus) DeepCopy() *RunStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepApproval) DeepCopyInto(out *StepApproval) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.NotifiedApprovers != nil {
+		in, out := &in.NotifiedApprovers, &out.NotifiedApprovers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastNotificationAt != nil {
+		in, out := &in.LastNotificationAt, &out.LastNotificationAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepApproval.
+func (in *StepApproval) DeepCopy() *StepApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(StepApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubCall) DeepCopyInto(out *SubCall) {
 	*out = *in
@@ -1640,6 +1888,92 @@ func (in *Webhook) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDelivery) DeepCopyInto(out *WebhookDelivery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDelivery.
+func (in *WebhookDelivery) DeepCopy() *WebhookDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebhookDelivery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDeliveryList) DeepCopyInto(out *WebhookDeliveryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebhookDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDeliveryList.
+func (in *WebhookDeliveryList) DeepCopy() *WebhookDeliveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDeliveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebhookDeliveryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDeliverySpec) DeepCopyInto(out *WebhookDeliverySpec) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDeliverySpec.
+func (in *WebhookDeliverySpec) DeepCopy() *WebhookDeliverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDeliverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookList) DeepCopyInto(out *WebhookList) {
 	*out = *in
@@ -1806,6 +2140,11 @@ func (in *WorkflowExecutionSpec) DeepCopyInto(out *WorkflowExecutionSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EmailReply != nil {
+		in, out := &in.EmailReply, &out.EmailReply
+		*out = new(EmailReply)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowExecutionSpec.
@@ -1821,15 +2160,28 @@ func (in *WorkflowExecutionSpec) DeepCopy() *WorkflowExecutionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowExecutionStatus) DeepCopyInto(out *WorkflowExecutionStatus) {
 	*out = *in
+	if in.OutputObject != nil {
+		in, out := &in.OutputObject, &out.OutputObject
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
 	if in.WorkflowManifest != nil {
 		in, out := &in.WorkflowManifest, &out.WorkflowManifest
 		*out = new(types.WorkflowManifest)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
 	if in.EndTime != nil {
 		in, out := &in.EndTime, &out.EndTime
 		*out = (*in).DeepCopy()
 	}
+	if in.EmailReplyLastAttemptAt != nil {
+		in, out := &in.EmailReplyLastAttemptAt, &out.EmailReplyLastAttemptAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowExecutionStatus.
@@ -2010,6 +2362,15 @@ func (in *WorkflowStepStatus) DeepCopyInto(out *WorkflowStepStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(StepApproval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStepStatus.
@@ -16,241 +16,272 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/obot-platform/obot/apiclient/types.Agent":                                     schema_obot_platform_obot_apiclient_types_Agent(ref),
-		"github.com/obot-platform/obot/apiclient/types.AgentIcons":                                schema_obot_platform_obot_apiclient_types_AgentIcons(ref),
-		"github.com/obot-platform/obot/apiclient/types.AgentList":                                 schema_obot_platform_obot_apiclient_types_AgentList(ref),
-		"github.com/obot-platform/obot/apiclient/types.AgentManifest":                             schema_obot_platform_obot_apiclient_types_AgentManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.Assistant":                                 schema_obot_platform_obot_apiclient_types_Assistant(ref),
-		"github.com/obot-platform/obot/apiclient/types.AssistantList":                             schema_obot_platform_obot_apiclient_types_AssistantList(ref),
-		"github.com/obot-platform/obot/apiclient/types.AssistantTool":                             schema_obot_platform_obot_apiclient_types_AssistantTool(ref),
-		"github.com/obot-platform/obot/apiclient/types.AssistantToolList":                         schema_obot_platform_obot_apiclient_types_AssistantToolList(ref),
-		"github.com/obot-platform/obot/apiclient/types.Credential":                                schema_obot_platform_obot_apiclient_types_Credential(ref),
-		"github.com/obot-platform/obot/apiclient/types.CredentialList":                            schema_obot_platform_obot_apiclient_types_CredentialList(ref),
-		"github.com/obot-platform/obot/apiclient/types.CronJob":                                   schema_obot_platform_obot_apiclient_types_CronJob(ref),
-		"github.com/obot-platform/obot/apiclient/types.CronJobList":                               schema_obot_platform_obot_apiclient_types_CronJobList(ref),
-		"github.com/obot-platform/obot/apiclient/types.CronJobManifest":                           schema_obot_platform_obot_apiclient_types_CronJobManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.DefaultModelAlias":                         schema_obot_platform_obot_apiclient_types_DefaultModelAlias(ref),
-		"github.com/obot-platform/obot/apiclient/types.DefaultModelAliasList":                     schema_obot_platform_obot_apiclient_types_DefaultModelAliasList(ref),
-		"github.com/obot-platform/obot/apiclient/types.DefaultModelAliasManifest":                 schema_obot_platform_obot_apiclient_types_DefaultModelAliasManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.EmailReceiver":                             schema_obot_platform_obot_apiclient_types_EmailReceiver(ref),
-		"github.com/obot-platform/obot/apiclient/types.EmailReceiverList":                         schema_obot_platform_obot_apiclient_types_EmailReceiverList(ref),
-		"github.com/obot-platform/obot/apiclient/types.EmailReceiverManifest":                     schema_obot_platform_obot_apiclient_types_EmailReceiverManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.EnvVar":                                    schema_obot_platform_obot_apiclient_types_EnvVar(ref),
-		"github.com/obot-platform/obot/apiclient/types.ErrHTTP":                                   schema_obot_platform_obot_apiclient_types_ErrHTTP(ref),
-		"github.com/obot-platform/obot/apiclient/types.File":                                      schema_obot_platform_obot_apiclient_types_File(ref),
-		"github.com/obot-platform/obot/apiclient/types.FileList":                                  schema_obot_platform_obot_apiclient_types_FileList(ref),
-		"github.com/obot-platform/obot/apiclient/types.If":                                        schema_obot_platform_obot_apiclient_types_If(ref),
-		"github.com/obot-platform/obot/apiclient/types.Item":                                      schema_obot_platform_obot_apiclient_types_Item(ref),
-		"github.com/obot-platform/obot/apiclient/types.KnowledgeFile":                             schema_obot_platform_obot_apiclient_types_KnowledgeFile(ref),
-		"github.com/obot-platform/obot/apiclient/types.KnowledgeFileList":                         schema_obot_platform_obot_apiclient_types_KnowledgeFileList(ref),
-		"github.com/obot-platform/obot/apiclient/types.KnowledgeSource":                           schema_obot_platform_obot_apiclient_types_KnowledgeSource(ref),
-		"github.com/obot-platform/obot/apiclient/types.KnowledgeSourceInput":                      schema_obot_platform_obot_apiclient_types_KnowledgeSourceInput(ref),
-		"github.com/obot-platform/obot/apiclient/types.KnowledgeSourceList":                       schema_obot_platform_obot_apiclient_types_KnowledgeSourceList(ref),
-		"github.com/obot-platform/obot/apiclient/types.KnowledgeSourceManifest":                   schema_obot_platform_obot_apiclient_types_KnowledgeSourceManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.Metadata":                                  schema_obot_platform_obot_apiclient_types_Metadata(ref),
-		"github.com/obot-platform/obot/apiclient/types.Model":                                     schema_obot_platform_obot_apiclient_types_Model(ref),
-		"github.com/obot-platform/obot/apiclient/types.ModelList":                                 schema_obot_platform_obot_apiclient_types_ModelList(ref),
-		"github.com/obot-platform/obot/apiclient/types.ModelManifest":                             schema_obot_platform_obot_apiclient_types_ModelManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.ModelProvider":                             schema_obot_platform_obot_apiclient_types_ModelProvider(ref),
-		"github.com/obot-platform/obot/apiclient/types.ModelProviderList":                         schema_obot_platform_obot_apiclient_types_ModelProviderList(ref),
-		"github.com/obot-platform/obot/apiclient/types.ModelProviderManifest":                     schema_obot_platform_obot_apiclient_types_ModelProviderManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.ModelProviderStatus":                       schema_obot_platform_obot_apiclient_types_ModelProviderStatus(ref),
-		"github.com/obot-platform/obot/apiclient/types.ModelStatus":                               schema_obot_platform_obot_apiclient_types_ModelStatus(ref),
-		"github.com/obot-platform/obot/apiclient/types.NotionConfig":                              schema_obot_platform_obot_apiclient_types_NotionConfig(ref),
-		"github.com/obot-platform/obot/apiclient/types.OAuthApp":                                  schema_obot_platform_obot_apiclient_types_OAuthApp(ref),
-		"github.com/obot-platform/obot/apiclient/types.OAuthAppList":                              schema_obot_platform_obot_apiclient_types_OAuthAppList(ref),
-		"github.com/obot-platform/obot/apiclient/types.OAuthAppLoginAuthStatus":                   schema_obot_platform_obot_apiclient_types_OAuthAppLoginAuthStatus(ref),
-		"github.com/obot-platform/obot/apiclient/types.OAuthAppManifest":                          schema_obot_platform_obot_apiclient_types_OAuthAppManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.OneDriveConfig":                            schema_obot_platform_obot_apiclient_types_OneDriveConfig(ref),
-		"github.com/obot-platform/obot/apiclient/types.Progress":                                  schema_obot_platform_obot_apiclient_types_Progress(ref),
-		"github.com/obot-platform/obot/apiclient/types.Prompt":                                    schema_obot_platform_obot_apiclient_types_Prompt(ref),
-		"github.com/obot-platform/obot/apiclient/types.PromptResponse":                            schema_obot_platform_obot_apiclient_types_PromptResponse(ref),
-		"github.com/obot-platform/obot/apiclient/types.Run":                                       schema_obot_platform_obot_apiclient_types_Run(ref),
-		"github.com/obot-platform/obot/apiclient/types.RunList":                                   schema_obot_platform_obot_apiclient_types_RunList(ref),
-		"github.com/obot-platform/obot/apiclient/types.Schedule":                                  schema_obot_platform_obot_apiclient_types_Schedule(ref),
-		"github.com/obot-platform/obot/apiclient/types.Step":                                      schema_obot_platform_obot_apiclient_types_Step(ref),
-		"github.com/obot-platform/obot/apiclient/types.StepTemplateInvoke":                        schema_obot_platform_obot_apiclient_types_StepTemplateInvoke(ref),
-		"github.com/obot-platform/obot/apiclient/types.SubFlow":                                   schema_obot_platform_obot_apiclient_types_SubFlow(ref),
-		"github.com/obot-platform/obot/apiclient/types.Table":                                     schema_obot_platform_obot_apiclient_types_Table(ref),
-		"github.com/obot-platform/obot/apiclient/types.TableList":                                 schema_obot_platform_obot_apiclient_types_TableList(ref),
-		"github.com/obot-platform/obot/apiclient/types.Task":                                      schema_obot_platform_obot_apiclient_types_Task(ref),
-		"github.com/obot-platform/obot/apiclient/types.TaskEmail":                                 schema_obot_platform_obot_apiclient_types_TaskEmail(ref),
-		"github.com/obot-platform/obot/apiclient/types.TaskIf":                                    schema_obot_platform_obot_apiclient_types_TaskIf(ref),
-		"github.com/obot-platform/obot/apiclient/types.TaskList":                                  schema_obot_platform_obot_apiclient_types_TaskList(ref),
-		"github.com/obot-platform/obot/apiclient/types.TaskManifest":                              schema_obot_platform_obot_apiclient_types_TaskManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.TaskOnDemand":                              schema_obot_platform_obot_apiclient_types_TaskOnDemand(ref),
-		"github.com/obot-platform/obot/apiclient/types.TaskRun":                                   schema_obot_platform_obot_apiclient_types_TaskRun(ref),
-		"github.com/obot-platform/obot/apiclient/types.TaskRunList":                               schema_obot_platform_obot_apiclient_types_TaskRunList(ref),
-		"github.com/obot-platform/obot/apiclient/types.TaskStep":                                  schema_obot_platform_obot_apiclient_types_TaskStep(ref),
-		"github.com/obot-platform/obot/apiclient/types.TaskWebhook":                               schema_obot_platform_obot_apiclient_types_TaskWebhook(ref),
-		"github.com/obot-platform/obot/apiclient/types.Template":                                  schema_obot_platform_obot_apiclient_types_Template(ref),
-		"github.com/obot-platform/obot/apiclient/types.Thread":                                    schema_obot_platform_obot_apiclient_types_Thread(ref),
-		"github.com/obot-platform/obot/apiclient/types.ThreadList":                                schema_obot_platform_obot_apiclient_types_ThreadList(ref),
-		"github.com/obot-platform/obot/apiclient/types.ThreadManifest":                            schema_obot_platform_obot_apiclient_types_ThreadManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.Time":                                      schema_obot_platform_obot_apiclient_types_Time(ref),
-		"github.com/obot-platform/obot/apiclient/types.ToolCall":                                  schema_obot_platform_obot_apiclient_types_ToolCall(ref),
-		"github.com/obot-platform/obot/apiclient/types.ToolInput":                                 schema_obot_platform_obot_apiclient_types_ToolInput(ref),
-		"github.com/obot-platform/obot/apiclient/types.ToolReference":                             schema_obot_platform_obot_apiclient_types_ToolReference(ref),
-		"github.com/obot-platform/obot/apiclient/types.ToolReferenceList":                         schema_obot_platform_obot_apiclient_types_ToolReferenceList(ref),
-		"github.com/obot-platform/obot/apiclient/types.ToolReferenceManifest":                     schema_obot_platform_obot_apiclient_types_ToolReferenceManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.User":                                      schema_obot_platform_obot_apiclient_types_User(ref),
-		"github.com/obot-platform/obot/apiclient/types.UserList":                                  schema_obot_platform_obot_apiclient_types_UserList(ref),
-		"github.com/obot-platform/obot/apiclient/types.Webhook":                                   schema_obot_platform_obot_apiclient_types_Webhook(ref),
-		"github.com/obot-platform/obot/apiclient/types.WebhookList":                               schema_obot_platform_obot_apiclient_types_WebhookList(ref),
-		"github.com/obot-platform/obot/apiclient/types.WebhookManifest":                           schema_obot_platform_obot_apiclient_types_WebhookManifest(ref),
-		"github.com/obot-platform/obot/apiclient/types.WebsiteCrawlingConfig":                     schema_obot_platform_obot_apiclient_types_WebsiteCrawlingConfig(ref),
-		"github.com/obot-platform/obot/apiclient/types.While":                                     schema_obot_platform_obot_apiclient_types_While(ref),
-		"github.com/obot-platform/obot/apiclient/types.Workflow":                                  schema_obot_platform_obot_apiclient_types_Workflow(ref),
-		"github.com/obot-platform/obot/apiclient/types.WorkflowCall":                              schema_obot_platform_obot_apiclient_types_WorkflowCall(ref),
-		"github.com/obot-platform/obot/apiclient/types.WorkflowExecution":                         schema_obot_platform_obot_apiclient_types_WorkflowExecution(ref),
-		"github.com/obot-platform/obot/apiclient/types.WorkflowExecutionList":                     schema_obot_platform_obot_apiclient_types_WorkflowExecutionList(ref),
-		"github.com/obot-platform/obot/apiclient/types.WorkflowList":                              schema_obot_platform_obot_apiclient_types_WorkflowList(ref),
-		"github.com/obot-platform/obot/apiclient/types.WorkflowManifest":                          schema_obot_platform_obot_apiclient_types_WorkflowManifest(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Agent":                   schema_storage_apis_ottootto8ai_v1_Agent(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.AgentList":               schema_storage_apis_ottootto8ai_v1_AgentList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.AgentSpec":               schema_storage_apis_ottootto8ai_v1_AgentSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.AgentStatus":             schema_storage_apis_ottootto8ai_v1_AgentStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Alias":                   schema_storage_apis_ottootto8ai_v1_Alias(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.AliasList":               schema_storage_apis_ottootto8ai_v1_AliasList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.AliasSpec":               schema_storage_apis_ottootto8ai_v1_AliasSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.CronJob":                 schema_storage_apis_ottootto8ai_v1_CronJob(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.CronJobList":             schema_storage_apis_ottootto8ai_v1_CronJobList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.CronJobSpec":             schema_storage_apis_ottootto8ai_v1_CronJobSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.CronJobStatus":           schema_storage_apis_ottootto8ai_v1_CronJobStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.DefaultModelAlias":       schema_storage_apis_ottootto8ai_v1_DefaultModelAlias(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.DefaultModelAliasList":   schema_storage_apis_ottootto8ai_v1_DefaultModelAliasList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.DefaultModelAliasSpec":   schema_storage_apis_ottootto8ai_v1_DefaultModelAliasSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.DefaultModelAliasStatus": schema_storage_apis_ottootto8ai_v1_DefaultModelAliasStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReceiver":           schema_storage_apis_ottootto8ai_v1_EmailReceiver(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReceiverList":       schema_storage_apis_ottootto8ai_v1_EmailReceiverList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReceiverSpec":       schema_storage_apis_ottootto8ai_v1_EmailReceiverSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReceiverStatus":     schema_storage_apis_ottootto8ai_v1_EmailReceiverStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmptyStatus":             schema_storage_apis_ottootto8ai_v1_EmptyStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeFile":           schema_storage_apis_ottootto8ai_v1_KnowledgeFile(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeFileList":       schema_storage_apis_ottootto8ai_v1_KnowledgeFileList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeFileSpec":       schema_storage_apis_ottootto8ai_v1_KnowledgeFileSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeFileStatus":     schema_storage_apis_ottootto8ai_v1_KnowledgeFileStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSet":            schema_storage_apis_ottootto8ai_v1_KnowledgeSet(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetList":        schema_storage_apis_ottootto8ai_v1_KnowledgeSetList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetManifest":    schema_storage_apis_ottootto8ai_v1_KnowledgeSetManifest(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetSpec":        schema_storage_apis_ottootto8ai_v1_KnowledgeSetSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetStatus":      schema_storage_apis_ottootto8ai_v1_KnowledgeSetStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSource":         schema_storage_apis_ottootto8ai_v1_KnowledgeSource(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceList":     schema_storage_apis_ottootto8ai_v1_KnowledgeSourceList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceSpec":     schema_storage_apis_ottootto8ai_v1_KnowledgeSourceSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceStatus":   schema_storage_apis_ottootto8ai_v1_KnowledgeSourceStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummary":        schema_storage_apis_ottootto8ai_v1_KnowledgeSummary(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummaryList":    schema_storage_apis_ottootto8ai_v1_KnowledgeSummaryList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummarySpec":    schema_storage_apis_ottootto8ai_v1_KnowledgeSummarySpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummaryStatus":  schema_storage_apis_ottootto8ai_v1_KnowledgeSummaryStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Model":                   schema_storage_apis_ottootto8ai_v1_Model(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelList":               schema_storage_apis_ottootto8ai_v1_ModelList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelSpec":               schema_storage_apis_ottootto8ai_v1_ModelSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelStatus":             schema_storage_apis_ottootto8ai_v1_ModelStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthApp":                schema_storage_apis_ottootto8ai_v1_OAuthApp(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppList":            schema_storage_apis_ottootto8ai_v1_OAuthAppList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppLogin":           schema_storage_apis_ottootto8ai_v1_OAuthAppLogin(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppLoginList":       schema_storage_apis_ottootto8ai_v1_OAuthAppLoginList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppLoginSpec":       schema_storage_apis_ottootto8ai_v1_OAuthAppLoginSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppLoginStatus":     schema_storage_apis_ottootto8ai_v1_OAuthAppLoginStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppSpec":            schema_storage_apis_ottootto8ai_v1_OAuthAppSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Ref":                     schema_storage_apis_ottootto8ai_v1_Ref(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Run":                     schema_storage_apis_ottootto8ai_v1_Run(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunList":                 schema_storage_apis_ottootto8ai_v1_RunList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunSpec":                 schema_storage_apis_ottootto8ai_v1_RunSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunState":                schema_storage_apis_ottootto8ai_v1_RunState(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunStateList":            schema_storage_apis_ottootto8ai_v1_RunStateList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunStateSpec":            schema_storage_apis_ottootto8ai_v1_RunStateSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunStatus":               schema_storage_apis_ottootto8ai_v1_RunStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.SubCall":                 schema_storage_apis_ottootto8ai_v1_SubCall(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Thread":                  schema_storage_apis_ottootto8ai_v1_Thread(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ThreadList":              schema_storage_apis_ottootto8ai_v1_ThreadList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ThreadSpec":              schema_storage_apis_ottootto8ai_v1_ThreadSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ThreadStatus":            schema_storage_apis_ottootto8ai_v1_ThreadStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolReference":           schema_storage_apis_ottootto8ai_v1_ToolReference(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolReferenceList":       schema_storage_apis_ottootto8ai_v1_ToolReferenceList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolReferenceSpec":       schema_storage_apis_ottootto8ai_v1_ToolReferenceSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolReferenceStatus":     schema_storage_apis_ottootto8ai_v1_ToolReferenceStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolShortDescription":    schema_storage_apis_ottootto8ai_v1_ToolShortDescription(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Webhook":                 schema_storage_apis_ottootto8ai_v1_Webhook(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookList":             schema_storage_apis_ottootto8ai_v1_WebhookList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookSpec":             schema_storage_apis_ottootto8ai_v1_WebhookSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookStatus":           schema_storage_apis_ottootto8ai_v1_WebhookStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Workflow":                schema_storage_apis_ottootto8ai_v1_Workflow(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowExecution":       schema_storage_apis_ottootto8ai_v1_WorkflowExecution(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowExecutionList":   schema_storage_apis_ottootto8ai_v1_WorkflowExecutionList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowExecutionSpec":   schema_storage_apis_ottootto8ai_v1_WorkflowExecutionSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowExecutionStatus": schema_storage_apis_ottootto8ai_v1_WorkflowExecutionStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowList":            schema_storage_apis_ottootto8ai_v1_WorkflowList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowSpec":            schema_storage_apis_ottootto8ai_v1_WorkflowSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowStatus":          schema_storage_apis_ottootto8ai_v1_WorkflowStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowStep":            schema_storage_apis_ottootto8ai_v1_WorkflowStep(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowStepList":        schema_storage_apis_ottootto8ai_v1_WorkflowStepList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowStepSpec":        schema_storage_apis_ottootto8ai_v1_WorkflowStepSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowStepStatus":      schema_storage_apis_ottootto8ai_v1_WorkflowStepStatus(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Workspace":               schema_storage_apis_ottootto8ai_v1_Workspace(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkspaceList":           schema_storage_apis_ottootto8ai_v1_WorkspaceList(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkspaceSpec":           schema_storage_apis_ottootto8ai_v1_WorkspaceSpec(ref),
-		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkspaceStatus":         schema_storage_apis_ottootto8ai_v1_WorkspaceStatus(ref),
-		"k8s.io/api/coordination/v1.Lease":                                                        schema_k8sio_api_coordination_v1_Lease(ref),
-		"k8s.io/api/coordination/v1.LeaseList":                                                    schema_k8sio_api_coordination_v1_LeaseList(ref),
-		"k8s.io/api/coordination/v1.LeaseSpec":                                                    schema_k8sio_api_coordination_v1_LeaseSpec(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                           schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                        schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                           schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                       schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                        schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                    schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                        schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                       schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                          schema_pkg_apis_meta_v1_Condition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                      schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                      schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                           schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                           schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                           schema_pkg_apis_meta_v1_FieldsV1(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                         schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                          schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                      schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                       schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                           schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                   schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                               schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                      schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                      schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                           schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                               schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                           schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                        schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                 schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                          schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                         schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                     schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                              schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                          schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                              schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                       schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                      schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                          schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                          schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                             schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                        schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                      schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                              schema_pkg_apis_meta_v1_Table(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                              schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                       schema_pkg_apis_meta_v1_TableOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                           schema_pkg_apis_meta_v1_TableRow(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                                  schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                               schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                          schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                           schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                      schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                         schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                            schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                 schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                         schema_apimachinery_pkg_util_intstr_IntOrString(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                                    schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/obot-platform/obot/apiclient/types.Agent":                                        schema_obot_platform_obot_apiclient_types_Agent(ref),
+		"github.com/obot-platform/obot/apiclient/types.AgentIcons":                                   schema_obot_platform_obot_apiclient_types_AgentIcons(ref),
+		"github.com/obot-platform/obot/apiclient/types.AgentList":                                    schema_obot_platform_obot_apiclient_types_AgentList(ref),
+		"github.com/obot-platform/obot/apiclient/types.AgentManifest":                                schema_obot_platform_obot_apiclient_types_AgentManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.Approval":                                     schema_obot_platform_obot_apiclient_types_Approval(ref),
+		"github.com/obot-platform/obot/apiclient/types.Assistant":                                    schema_obot_platform_obot_apiclient_types_Assistant(ref),
+		"github.com/obot-platform/obot/apiclient/types.AssistantList":                                schema_obot_platform_obot_apiclient_types_AssistantList(ref),
+		"github.com/obot-platform/obot/apiclient/types.AssistantTool":                                schema_obot_platform_obot_apiclient_types_AssistantTool(ref),
+		"github.com/obot-platform/obot/apiclient/types.AssistantToolList":                            schema_obot_platform_obot_apiclient_types_AssistantToolList(ref),
+		"github.com/obot-platform/obot/apiclient/types.Credential":                                   schema_obot_platform_obot_apiclient_types_Credential(ref),
+		"github.com/obot-platform/obot/apiclient/types.CredentialList":                               schema_obot_platform_obot_apiclient_types_CredentialList(ref),
+		"github.com/obot-platform/obot/apiclient/types.CronJob":                                      schema_obot_platform_obot_apiclient_types_CronJob(ref),
+		"github.com/obot-platform/obot/apiclient/types.CronJobList":                                  schema_obot_platform_obot_apiclient_types_CronJobList(ref),
+		"github.com/obot-platform/obot/apiclient/types.CronJobManifest":                              schema_obot_platform_obot_apiclient_types_CronJobManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.DefaultModelAlias":                            schema_obot_platform_obot_apiclient_types_DefaultModelAlias(ref),
+		"github.com/obot-platform/obot/apiclient/types.DefaultModelAliasList":                        schema_obot_platform_obot_apiclient_types_DefaultModelAliasList(ref),
+		"github.com/obot-platform/obot/apiclient/types.DefaultModelAliasManifest":                    schema_obot_platform_obot_apiclient_types_DefaultModelAliasManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.EmailReceiver":                                schema_obot_platform_obot_apiclient_types_EmailReceiver(ref),
+		"github.com/obot-platform/obot/apiclient/types.EmailReceiverList":                            schema_obot_platform_obot_apiclient_types_EmailReceiverList(ref),
+		"github.com/obot-platform/obot/apiclient/types.EmailReceiverManifest":                        schema_obot_platform_obot_apiclient_types_EmailReceiverManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.EnvVar":                                       schema_obot_platform_obot_apiclient_types_EnvVar(ref),
+		"github.com/obot-platform/obot/apiclient/types.ErrHTTP":                                      schema_obot_platform_obot_apiclient_types_ErrHTTP(ref),
+		"github.com/obot-platform/obot/apiclient/types.File":                                         schema_obot_platform_obot_apiclient_types_File(ref),
+		"github.com/obot-platform/obot/apiclient/types.FileList":                                     schema_obot_platform_obot_apiclient_types_FileList(ref),
+		"github.com/obot-platform/obot/apiclient/types.ForEach":                                      schema_obot_platform_obot_apiclient_types_ForEach(ref),
+		"github.com/obot-platform/obot/apiclient/types.If":                                           schema_obot_platform_obot_apiclient_types_If(ref),
+		"github.com/obot-platform/obot/apiclient/types.Item":                                         schema_obot_platform_obot_apiclient_types_Item(ref),
+		"github.com/obot-platform/obot/apiclient/types.KnowledgeFile":                                schema_obot_platform_obot_apiclient_types_KnowledgeFile(ref),
+		"github.com/obot-platform/obot/apiclient/types.KnowledgeFileList":                            schema_obot_platform_obot_apiclient_types_KnowledgeFileList(ref),
+		"github.com/obot-platform/obot/apiclient/types.KnowledgeSource":                              schema_obot_platform_obot_apiclient_types_KnowledgeSource(ref),
+		"github.com/obot-platform/obot/apiclient/types.KnowledgeSourceInput":                         schema_obot_platform_obot_apiclient_types_KnowledgeSourceInput(ref),
+		"github.com/obot-platform/obot/apiclient/types.KnowledgeSourceList":                          schema_obot_platform_obot_apiclient_types_KnowledgeSourceList(ref),
+		"github.com/obot-platform/obot/apiclient/types.KnowledgeSourceManifest":                      schema_obot_platform_obot_apiclient_types_KnowledgeSourceManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.Metadata":                                     schema_obot_platform_obot_apiclient_types_Metadata(ref),
+		"github.com/obot-platform/obot/apiclient/types.Model":                                        schema_obot_platform_obot_apiclient_types_Model(ref),
+		"github.com/obot-platform/obot/apiclient/types.ModelList":                                    schema_obot_platform_obot_apiclient_types_ModelList(ref),
+		"github.com/obot-platform/obot/apiclient/types.ModelManifest":                                schema_obot_platform_obot_apiclient_types_ModelManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.ModelProvider":                                schema_obot_platform_obot_apiclient_types_ModelProvider(ref),
+		"github.com/obot-platform/obot/apiclient/types.ModelProviderList":                            schema_obot_platform_obot_apiclient_types_ModelProviderList(ref),
+		"github.com/obot-platform/obot/apiclient/types.ModelProviderManifest":                        schema_obot_platform_obot_apiclient_types_ModelProviderManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.ModelProviderStatus":                          schema_obot_platform_obot_apiclient_types_ModelProviderStatus(ref),
+		"github.com/obot-platform/obot/apiclient/types.ModelStatus":                                  schema_obot_platform_obot_apiclient_types_ModelStatus(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationPayload":                          schema_obot_platform_obot_apiclient_types_NotificationPayload(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationTarget":                           schema_obot_platform_obot_apiclient_types_NotificationTarget(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationTargetList":                       schema_obot_platform_obot_apiclient_types_NotificationTargetList(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationTargetManifest":                   schema_obot_platform_obot_apiclient_types_NotificationTargetManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotionConfig":                                 schema_obot_platform_obot_apiclient_types_NotionConfig(ref),
+		"github.com/obot-platform/obot/apiclient/types.OAuthApp":                                     schema_obot_platform_obot_apiclient_types_OAuthApp(ref),
+		"github.com/obot-platform/obot/apiclient/types.OAuthAppList":                                 schema_obot_platform_obot_apiclient_types_OAuthAppList(ref),
+		"github.com/obot-platform/obot/apiclient/types.OAuthAppLoginAuthStatus":                      schema_obot_platform_obot_apiclient_types_OAuthAppLoginAuthStatus(ref),
+		"github.com/obot-platform/obot/apiclient/types.OAuthAppManifest":                             schema_obot_platform_obot_apiclient_types_OAuthAppManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.OneDriveConfig":                               schema_obot_platform_obot_apiclient_types_OneDriveConfig(ref),
+		"github.com/obot-platform/obot/apiclient/types.Parallel":                                     schema_obot_platform_obot_apiclient_types_Parallel(ref),
+		"github.com/obot-platform/obot/apiclient/types.ParallelBranch":                               schema_obot_platform_obot_apiclient_types_ParallelBranch(ref),
+		"github.com/obot-platform/obot/apiclient/types.Progress":                                     schema_obot_platform_obot_apiclient_types_Progress(ref),
+		"github.com/obot-platform/obot/apiclient/types.Prompt":                                       schema_obot_platform_obot_apiclient_types_Prompt(ref),
+		"github.com/obot-platform/obot/apiclient/types.PromptResponse":                               schema_obot_platform_obot_apiclient_types_PromptResponse(ref),
+		"github.com/obot-platform/obot/apiclient/types.Retry":                                        schema_obot_platform_obot_apiclient_types_Retry(ref),
+		"github.com/obot-platform/obot/apiclient/types.Run":                                          schema_obot_platform_obot_apiclient_types_Run(ref),
+		"github.com/obot-platform/obot/apiclient/types.RunList":                                      schema_obot_platform_obot_apiclient_types_RunList(ref),
+		"github.com/obot-platform/obot/apiclient/types.Schedule":                                     schema_obot_platform_obot_apiclient_types_Schedule(ref),
+		"github.com/obot-platform/obot/apiclient/types.Step":                                         schema_obot_platform_obot_apiclient_types_Step(ref),
+		"github.com/obot-platform/obot/apiclient/types.StepTemplateInvoke":                           schema_obot_platform_obot_apiclient_types_StepTemplateInvoke(ref),
+		"github.com/obot-platform/obot/apiclient/types.SubFlow":                                      schema_obot_platform_obot_apiclient_types_SubFlow(ref),
+		"github.com/obot-platform/obot/apiclient/types.Table":                                        schema_obot_platform_obot_apiclient_types_Table(ref),
+		"github.com/obot-platform/obot/apiclient/types.TableList":                                    schema_obot_platform_obot_apiclient_types_TableList(ref),
+		"github.com/obot-platform/obot/apiclient/types.Task":                                         schema_obot_platform_obot_apiclient_types_Task(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskEmail":                                    schema_obot_platform_obot_apiclient_types_TaskEmail(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskIf":                                       schema_obot_platform_obot_apiclient_types_TaskIf(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskList":                                     schema_obot_platform_obot_apiclient_types_TaskList(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskManifest":                                 schema_obot_platform_obot_apiclient_types_TaskManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskOnDemand":                                 schema_obot_platform_obot_apiclient_types_TaskOnDemand(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskRun":                                      schema_obot_platform_obot_apiclient_types_TaskRun(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskRunList":                                  schema_obot_platform_obot_apiclient_types_TaskRunList(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskStep":                                     schema_obot_platform_obot_apiclient_types_TaskStep(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskWebhook":                                  schema_obot_platform_obot_apiclient_types_TaskWebhook(ref),
+		"github.com/obot-platform/obot/apiclient/types.Template":                                     schema_obot_platform_obot_apiclient_types_Template(ref),
+		"github.com/obot-platform/obot/apiclient/types.Thread":                                       schema_obot_platform_obot_apiclient_types_Thread(ref),
+		"github.com/obot-platform/obot/apiclient/types.ThreadList":                                   schema_obot_platform_obot_apiclient_types_ThreadList(ref),
+		"github.com/obot-platform/obot/apiclient/types.ThreadManifest":                               schema_obot_platform_obot_apiclient_types_ThreadManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.Time":                                         schema_obot_platform_obot_apiclient_types_Time(ref),
+		"github.com/obot-platform/obot/apiclient/types.TokenUsage":                                   schema_obot_platform_obot_apiclient_types_TokenUsage(ref),
+		"github.com/obot-platform/obot/apiclient/types.ToolCall":                                     schema_obot_platform_obot_apiclient_types_ToolCall(ref),
+		"github.com/obot-platform/obot/apiclient/types.ToolInput":                                    schema_obot_platform_obot_apiclient_types_ToolInput(ref),
+		"github.com/obot-platform/obot/apiclient/types.ToolReference":                                schema_obot_platform_obot_apiclient_types_ToolReference(ref),
+		"github.com/obot-platform/obot/apiclient/types.ToolReferenceList":                            schema_obot_platform_obot_apiclient_types_ToolReferenceList(ref),
+		"github.com/obot-platform/obot/apiclient/types.ToolReferenceManifest":                        schema_obot_platform_obot_apiclient_types_ToolReferenceManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.User":                                         schema_obot_platform_obot_apiclient_types_User(ref),
+		"github.com/obot-platform/obot/apiclient/types.UserList":                                     schema_obot_platform_obot_apiclient_types_UserList(ref),
+		"github.com/obot-platform/obot/apiclient/types.Webhook":                                      schema_obot_platform_obot_apiclient_types_Webhook(ref),
+		"github.com/obot-platform/obot/apiclient/types.WebhookDelivery":                              schema_obot_platform_obot_apiclient_types_WebhookDelivery(ref),
+		"github.com/obot-platform/obot/apiclient/types.WebhookDeliveryList":                          schema_obot_platform_obot_apiclient_types_WebhookDeliveryList(ref),
+		"github.com/obot-platform/obot/apiclient/types.WebhookList":                                  schema_obot_platform_obot_apiclient_types_WebhookList(ref),
+		"github.com/obot-platform/obot/apiclient/types.WebhookManifest":                              schema_obot_platform_obot_apiclient_types_WebhookManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.WebhookRateLimit":                             schema_obot_platform_obot_apiclient_types_WebhookRateLimit(ref),
+		"github.com/obot-platform/obot/apiclient/types.WebhookResponse":                              schema_obot_platform_obot_apiclient_types_WebhookResponse(ref),
+		"github.com/obot-platform/obot/apiclient/types.WebsiteCrawlingConfig":                        schema_obot_platform_obot_apiclient_types_WebsiteCrawlingConfig(ref),
+		"github.com/obot-platform/obot/apiclient/types.While":                                        schema_obot_platform_obot_apiclient_types_While(ref),
+		"github.com/obot-platform/obot/apiclient/types.Workflow":                                     schema_obot_platform_obot_apiclient_types_Workflow(ref),
+		"github.com/obot-platform/obot/apiclient/types.WorkflowCall":                                 schema_obot_platform_obot_apiclient_types_WorkflowCall(ref),
+		"github.com/obot-platform/obot/apiclient/types.WorkflowExecution":                            schema_obot_platform_obot_apiclient_types_WorkflowExecution(ref),
+		"github.com/obot-platform/obot/apiclient/types.WorkflowExecutionApproval":                    schema_obot_platform_obot_apiclient_types_WorkflowExecutionApproval(ref),
+		"github.com/obot-platform/obot/apiclient/types.WorkflowExecutionDetail":                      schema_obot_platform_obot_apiclient_types_WorkflowExecutionDetail(ref),
+		"github.com/obot-platform/obot/apiclient/types.WorkflowExecutionList":                        schema_obot_platform_obot_apiclient_types_WorkflowExecutionList(ref),
+		"github.com/obot-platform/obot/apiclient/types.WorkflowExecutionResume":                      schema_obot_platform_obot_apiclient_types_WorkflowExecutionResume(ref),
+		"github.com/obot-platform/obot/apiclient/types.WorkflowExecutionStep":                        schema_obot_platform_obot_apiclient_types_WorkflowExecutionStep(ref),
+		"github.com/obot-platform/obot/apiclient/types.WorkflowList":                                 schema_obot_platform_obot_apiclient_types_WorkflowList(ref),
+		"github.com/obot-platform/obot/apiclient/types.WorkflowManifest":                             schema_obot_platform_obot_apiclient_types_WorkflowManifest(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Agent":                      schema_storage_apis_ottootto8ai_v1_Agent(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.AgentList":                  schema_storage_apis_ottootto8ai_v1_AgentList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.AgentSpec":                  schema_storage_apis_ottootto8ai_v1_AgentSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.AgentStatus":                schema_storage_apis_ottootto8ai_v1_AgentStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Alias":                      schema_storage_apis_ottootto8ai_v1_Alias(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.AliasList":                  schema_storage_apis_ottootto8ai_v1_AliasList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.AliasSpec":                  schema_storage_apis_ottootto8ai_v1_AliasSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.CronJob":                    schema_storage_apis_ottootto8ai_v1_CronJob(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.CronJobList":                schema_storage_apis_ottootto8ai_v1_CronJobList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.CronJobSpec":                schema_storage_apis_ottootto8ai_v1_CronJobSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.CronJobStatus":              schema_storage_apis_ottootto8ai_v1_CronJobStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.DefaultModelAlias":          schema_storage_apis_ottootto8ai_v1_DefaultModelAlias(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.DefaultModelAliasList":      schema_storage_apis_ottootto8ai_v1_DefaultModelAliasList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.DefaultModelAliasSpec":      schema_storage_apis_ottootto8ai_v1_DefaultModelAliasSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.DefaultModelAliasStatus":    schema_storage_apis_ottootto8ai_v1_DefaultModelAliasStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReceiver":              schema_storage_apis_ottootto8ai_v1_EmailReceiver(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReceiverList":          schema_storage_apis_ottootto8ai_v1_EmailReceiverList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReceiverSpec":          schema_storage_apis_ottootto8ai_v1_EmailReceiverSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReceiverStatus":        schema_storage_apis_ottootto8ai_v1_EmailReceiverStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReply":                 schema_storage_apis_ottootto8ai_v1_EmailReply(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmptyStatus":                schema_storage_apis_ottootto8ai_v1_EmptyStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeFile":              schema_storage_apis_ottootto8ai_v1_KnowledgeFile(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeFileList":          schema_storage_apis_ottootto8ai_v1_KnowledgeFileList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeFileSpec":          schema_storage_apis_ottootto8ai_v1_KnowledgeFileSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeFileStatus":        schema_storage_apis_ottootto8ai_v1_KnowledgeFileStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSet":               schema_storage_apis_ottootto8ai_v1_KnowledgeSet(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetList":           schema_storage_apis_ottootto8ai_v1_KnowledgeSetList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetManifest":       schema_storage_apis_ottootto8ai_v1_KnowledgeSetManifest(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetSpec":           schema_storage_apis_ottootto8ai_v1_KnowledgeSetSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetStatus":         schema_storage_apis_ottootto8ai_v1_KnowledgeSetStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSource":            schema_storage_apis_ottootto8ai_v1_KnowledgeSource(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceList":        schema_storage_apis_ottootto8ai_v1_KnowledgeSourceList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceSpec":        schema_storage_apis_ottootto8ai_v1_KnowledgeSourceSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceStatus":      schema_storage_apis_ottootto8ai_v1_KnowledgeSourceStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummary":           schema_storage_apis_ottootto8ai_v1_KnowledgeSummary(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummaryList":       schema_storage_apis_ottootto8ai_v1_KnowledgeSummaryList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummarySpec":       schema_storage_apis_ottootto8ai_v1_KnowledgeSummarySpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummaryStatus":     schema_storage_apis_ottootto8ai_v1_KnowledgeSummaryStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Model":                      schema_storage_apis_ottootto8ai_v1_Model(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelList":                  schema_storage_apis_ottootto8ai_v1_ModelList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelSpec":                  schema_storage_apis_ottootto8ai_v1_ModelSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelStatus":                schema_storage_apis_ottootto8ai_v1_ModelStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationDelivery":       schema_storage_apis_ottootto8ai_v1_NotificationDelivery(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationDeliveryList":   schema_storage_apis_ottootto8ai_v1_NotificationDeliveryList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationDeliverySpec":   schema_storage_apis_ottootto8ai_v1_NotificationDeliverySpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationDeliveryStatus": schema_storage_apis_ottootto8ai_v1_NotificationDeliveryStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationTarget":         schema_storage_apis_ottootto8ai_v1_NotificationTarget(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationTargetList":     schema_storage_apis_ottootto8ai_v1_NotificationTargetList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationTargetSpec":     schema_storage_apis_ottootto8ai_v1_NotificationTargetSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationTargetStatus":   schema_storage_apis_ottootto8ai_v1_NotificationTargetStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthApp":                   schema_storage_apis_ottootto8ai_v1_OAuthApp(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppList":               schema_storage_apis_ottootto8ai_v1_OAuthAppList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppLogin":              schema_storage_apis_ottootto8ai_v1_OAuthAppLogin(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppLoginList":          schema_storage_apis_ottootto8ai_v1_OAuthAppLoginList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppLoginSpec":          schema_storage_apis_ottootto8ai_v1_OAuthAppLoginSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppLoginStatus":        schema_storage_apis_ottootto8ai_v1_OAuthAppLoginStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.OAuthAppSpec":               schema_storage_apis_ottootto8ai_v1_OAuthAppSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Ref":                        schema_storage_apis_ottootto8ai_v1_Ref(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Run":                        schema_storage_apis_ottootto8ai_v1_Run(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunList":                    schema_storage_apis_ottootto8ai_v1_RunList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunSpec":                    schema_storage_apis_ottootto8ai_v1_RunSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunState":                   schema_storage_apis_ottootto8ai_v1_RunState(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunStateList":               schema_storage_apis_ottootto8ai_v1_RunStateList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunStateSpec":               schema_storage_apis_ottootto8ai_v1_RunStateSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.RunStatus":                  schema_storage_apis_ottootto8ai_v1_RunStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.StepApproval":               schema_storage_apis_ottootto8ai_v1_StepApproval(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.SubCall":                    schema_storage_apis_ottootto8ai_v1_SubCall(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Thread":                     schema_storage_apis_ottootto8ai_v1_Thread(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ThreadList":                 schema_storage_apis_ottootto8ai_v1_ThreadList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ThreadSpec":                 schema_storage_apis_ottootto8ai_v1_ThreadSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ThreadStatus":               schema_storage_apis_ottootto8ai_v1_ThreadStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolReference":              schema_storage_apis_ottootto8ai_v1_ToolReference(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolReferenceList":          schema_storage_apis_ottootto8ai_v1_ToolReferenceList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolReferenceSpec":          schema_storage_apis_ottootto8ai_v1_ToolReferenceSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolReferenceStatus":        schema_storage_apis_ottootto8ai_v1_ToolReferenceStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolShortDescription":       schema_storage_apis_ottootto8ai_v1_ToolShortDescription(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Webhook":                    schema_storage_apis_ottootto8ai_v1_Webhook(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookDelivery":            schema_storage_apis_ottootto8ai_v1_WebhookDelivery(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookDeliveryList":        schema_storage_apis_ottootto8ai_v1_WebhookDeliveryList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookDeliverySpec":        schema_storage_apis_ottootto8ai_v1_WebhookDeliverySpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookList":                schema_storage_apis_ottootto8ai_v1_WebhookList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookSpec":                schema_storage_apis_ottootto8ai_v1_WebhookSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookStatus":              schema_storage_apis_ottootto8ai_v1_WebhookStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Workflow":                   schema_storage_apis_ottootto8ai_v1_Workflow(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowExecution":          schema_storage_apis_ottootto8ai_v1_WorkflowExecution(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowExecutionList":      schema_storage_apis_ottootto8ai_v1_WorkflowExecutionList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowExecutionSpec":      schema_storage_apis_ottootto8ai_v1_WorkflowExecutionSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowExecutionStatus":    schema_storage_apis_ottootto8ai_v1_WorkflowExecutionStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowList":               schema_storage_apis_ottootto8ai_v1_WorkflowList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowSpec":               schema_storage_apis_ottootto8ai_v1_WorkflowSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowStatus":             schema_storage_apis_ottootto8ai_v1_WorkflowStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowStep":               schema_storage_apis_ottootto8ai_v1_WorkflowStep(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowStepList":           schema_storage_apis_ottootto8ai_v1_WorkflowStepList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowStepSpec":           schema_storage_apis_ottootto8ai_v1_WorkflowStepSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkflowStepStatus":         schema_storage_apis_ottootto8ai_v1_WorkflowStepStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Workspace":                  schema_storage_apis_ottootto8ai_v1_Workspace(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkspaceList":              schema_storage_apis_ottootto8ai_v1_WorkspaceList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkspaceSpec":              schema_storage_apis_ottootto8ai_v1_WorkspaceSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WorkspaceStatus":            schema_storage_apis_ottootto8ai_v1_WorkspaceStatus(ref),
+		"k8s.io/api/coordination/v1.Lease":                                                           schema_k8sio_api_coordination_v1_Lease(ref),
+		"k8s.io/api/coordination/v1.LeaseList":                                                       schema_k8sio_api_coordination_v1_LeaseList(ref),
+		"k8s.io/api/coordination/v1.LeaseSpec":                                                       schema_k8sio_api_coordination_v1_LeaseSpec(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                              schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                           schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                              schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                          schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                           schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                       schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                           schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                          schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                             schema_pkg_apis_meta_v1_Condition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                         schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                         schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                              schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                              schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                              schema_pkg_apis_meta_v1_FieldsV1(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                            schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                             schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                         schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                          schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                              schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                      schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                  schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                         schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                         schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                              schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                  schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                              schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                           schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                    schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                             schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                            schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                        schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                                 schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                             schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                 schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                          schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                         schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                             schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                             schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                                schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                           schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                         schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                                 schema_pkg_apis_meta_v1_Table(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                                 schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                          schema_pkg_apis_meta_v1_TableOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                              schema_pkg_apis_meta_v1_TableRow(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                                     schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                  schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                             schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                              schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                         schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                            schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                               schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                   schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                    schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                            schema_apimachinery_pkg_util_intstr_IntOrString(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                       schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
@@ -576,6 +607,39 @@ func schema_obot_platform_obot_apiclient_types_AgentManifest(ref common.Referenc
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_Approval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"approvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approvers are the email addresses that a link to approve or reject the step is sent to. Sending requires an outbound SMTP relay.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_Assistant(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -901,8 +965,16 @@ func schema_obot_platform_obot_apiclient_types_CronJobManifest(ref common.Refere
 					},
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Schedule is a cron expression with optional seconds, such as \"0 9 * * 1-5\", or a descriptor such as \"@daily\" or \"@every 1h30m\". It can start with \"CRON_TZ=<timezone>\" to set its own timezone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the IANA timezone the schedule is evaluated in, such as \"America/New_York\". The default is the server's timezone.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"workflow": {
@@ -922,6 +994,34 @@ func schema_obot_platform_obot_apiclient_types_CronJobManifest(ref common.Refere
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Schedule"),
 						},
 					},
+					"concurrencyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrencyPolicy decides what happens when a run is due while an execution of the cron job is still running. The default is Allow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startingDeadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartingDeadline is how late a run can start after its scheduled time, such as \"10m\". Later runs are skipped. By default, late runs always start.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"catchUp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatchUp starts a run for each scheduled time that was missed, such as while the server was down, instead of a single run for all of them. At most the 10 most recent missed runs are started.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"suspended": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspended stops scheduled runs. The runs missed while suspended are not caught up. It is changed with the suspend and resume actions, and updates of the cron job keep its current value.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1120,6 +1220,41 @@ func schema_obot_platform_obot_apiclient_types_EmailReceiverManifest(ref common.
 							},
 						},
 					},
+					"authenticationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthenticationPolicy decides what happens to email that is not authenticated by SPF or DKIM aligned with the domain of its From header. The default is flag.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suspended": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspended stops the receiver from starting its workflow. Received emails are dropped. It is changed with the suspend and resume actions, and updates of the receiver keep its current value.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"maxAttachmentSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAttachmentSize is the largest attachment, in bytes, that is written to the workspace of the workflow execution. Larger attachments are listed in the input but not written. The default is 10MB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxTotalAttachmentSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxTotalAttachmentSize is the most bytes of attachments that are written for one email. The default is 25MB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"replyWithOutput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplyWithOutput sends the output of the workflow back to the sender when the execution completes. Replies to that email continue the same thread. The reply goes to the From header address, and only if the email is authenticated and that address is an allowed sender. The server must be configured with an outbound SMTP relay.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "description", "workflow"},
 			},
@@ -1234,6 +1369,45 @@ func schema_obot_platform_obot_apiclient_types_FileList(ref common.ReferenceCall
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_ForEach(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"maxConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Step"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Step"},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_If(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1246,6 +1420,12 @@ func schema_obot_platform_obot_apiclient_types_If(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"steps": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -2035,38 +2215,134 @@ func schema_obot_platform_obot_apiclient_types_ModelStatus(ref common.ReferenceC
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_NotionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotificationPayload(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "NotificationPayload is the JSON body of a notification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"event": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"workflowID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workflowExecutionID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"agentID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"threadID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"runID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"output": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"authURL": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"id", "event", "time"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthApp(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotificationTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"OAuthAppManifest": {
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"),
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"NotificationTargetManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.NotificationTargetManifest"),
+						},
+					},
+					"lastDeliveryAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"lastDeliveryError": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"OAuthAppManifest"},
+				Required: []string{"Metadata", "NotificationTargetManifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"},
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.NotificationTargetManifest", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthAppList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotificationTargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -2079,7 +2355,7 @@ func schema_obot_platform_obot_apiclient_types_OAuthAppList(ref common.Reference
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthApp"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.NotificationTarget"),
 									},
 								},
 							},
@@ -2090,38 +2366,162 @@ func schema_obot_platform_obot_apiclient_types_OAuthAppList(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.OAuthApp"},
+			"github.com/obot-platform/obot/apiclient/types.NotificationTarget"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthAppLoginAuthStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotificationTargetManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"authenticated": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "URL is where notifications are sent with a POST request.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"required": {
+					"headers": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Headers are added to each notification request.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"error": {
+					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Secret signs notifications. The X-Obot-Signature header is \"sha256=\" and the hex HMAC-SHA256 of \"<timestamp>.<body>\", where the timestamp is the X-Obot-Timestamp header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events are the events sent to the target. All events are sent if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_NotionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_OAuthApp(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"OAuthAppManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"),
+						},
+					},
+				},
+				Required: []string{"OAuthAppManifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_OAuthAppList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthApp"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.OAuthApp"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_OAuthAppLoginAuthStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"authenticated": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"required": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
@@ -2258,6 +2658,61 @@ func schema_obot_platform_obot_apiclient_types_OneDriveConfig(ref common.Referen
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_Parallel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Parallel runs the steps of each branch concurrently.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"branches": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ParallelBranch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ParallelBranch"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ParallelBranch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Step"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Step"},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_Progress(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2497,6 +2952,54 @@ func schema_obot_platform_obot_apiclient_types_PromptResponse(ref common.Referen
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_Retry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAttempts is the total number of times the step is run, including the first attempt.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff is the delay before the first retry, such as \"30s\". The delay doubles for each following retry.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackoff caps the delay between retries.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"on": {
+						SchemaProps: spec.SchemaProps{
+							Description: "On lists the error classes that are retried. All errors are retried if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_Run(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2581,12 +3084,18 @@ func schema_obot_platform_obot_apiclient_types_Run(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
+					"tokenUsage": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TokenUsage"),
+						},
+					},
 				},
 				Required: []string{"input"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.Time", "github.com/obot-platform/obot/apiclient/types.TokenUsage"},
 	}
 }
 
@@ -2660,6 +3169,13 @@ func schema_obot_platform_obot_apiclient_types_Schedule(ref common.ReferenceCall
 							Format:  "int32",
 						},
 					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the IANA timezone of the hour, such as \"Europe/Berlin\". The default is the server's timezone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"interval", "hour", "minute", "day", "weekday"},
 			},
@@ -2701,6 +3217,21 @@ func schema_obot_platform_obot_apiclient_types_Step(ref common.ReferenceCallback
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.While"),
 						},
 					},
+					"parallel": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Parallel"),
+						},
+					},
+					"forEach": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.ForEach"),
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Approval"),
+						},
+					},
 					"template": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Template"),
@@ -2766,11 +3297,36 @@ func schema_obot_platform_obot_apiclient_types_Step(ref common.ReferenceCallback
 							Format: "float",
 						},
 					},
+					"retry": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Retry"),
+						},
+					},
+					"outputName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"outputSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputSchema is a JSON Schema the output of the step must match. The step is re-prompted if it does not.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the maximum duration of the step including retries and nested steps, such as \"5m\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.If", "github.com/obot-platform/obot/apiclient/types.Template", "github.com/obot-platform/obot/apiclient/types.While"},
+			"github.com/obot-platform/obot/apiclient/types.Approval", "github.com/obot-platform/obot/apiclient/types.ForEach", "github.com/obot-platform/obot/apiclient/types.If", "github.com/obot-platform/obot/apiclient/types.Parallel", "github.com/obot-platform/obot/apiclient/types.Retry", "github.com/obot-platform/obot/apiclient/types.Template", "github.com/obot-platform/obot/apiclient/types.While"},
 	}
 }
 
@@ -3432,35 +3988,65 @@ func schema_obot_platform_obot_apiclient_types_Time(ref common.ReferenceCallback
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ToolCall(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TokenUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"promptTokens": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"description": {
+					"completionTokens": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"input": {
+					"totalTokens": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"output": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ToolCall(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"input": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"output": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
@@ -3784,6 +4370,130 @@ func schema_obot_platform_obot_apiclient_types_Webhook(ref common.ReferenceCallb
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_WebhookDelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"webhookID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"bodySize": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"bodySHA256": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"statusCode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workflowExecutionID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"idempotencyKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"replayOf": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"replayable": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"Metadata"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_WebhookDeliveryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.WebhookDelivery"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.WebhookDelivery"},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_WebhookList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3874,23 +4584,54 @@ func schema_obot_platform_obot_apiclient_types_WebhookManifest(ref common.Refere
 							Format:  "",
 						},
 					},
-				},
-				Required: []string{"name", "description", "alias", "workflow", "headers", "secret", "validationHeader"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_WebsiteCrawlingConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"urls": {
+					"suspended": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Suspended stops the webhook from starting its workflow. Deliveries get 503 Service Unavailable. It is changed with the suspend and resume actions, and updates of the webhook keep its current value.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"signatureScheme": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureScheme is how deliveries are signed with the secret. The default is an HMAC-SHA256 of the body in the validation header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"signatureEncoding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureEncoding is the encoding of the signature for the hmac and timestampedHMAC schemes. The default is hex.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestampHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimestampHeader is the header with the delivery timestamp for the timestampedHMAC scheme.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replayWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplayWindow is how old the timestamp of a signed delivery can be, such as \"5m\". The default is 5m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter is a CEL expression evaluated against the \"payload\" and \"headers\" of a delivery. Deliveries that do not match are accepted without starting the workflow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mapping maps the fields of the workflow input to CEL expressions evaluated against the \"payload\" and \"headers\" of a delivery. If set, the workflow input is only the mapped fields instead of the whole delivery.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -3901,56 +4642,189 @@ func schema_obot_platform_obot_apiclient_types_WebsiteCrawlingConfig(ref common.
 							},
 						},
 					},
+					"synchronous": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Synchronous makes the webhook wait for the workflow to finish and respond with its output.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"synchronousTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SynchronousTimeout is the longest a synchronous webhook waits for the workflow, such as \"30s\". If the workflow has not finished by then, the webhook responds with 202 Accepted. The default is 30s.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits how often the webhook accepts deliveries. Deliveries over the limit get 429 Too Many Requests.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.WebhookRateLimit"),
+						},
+					},
+					"idempotencyHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdempotencyHeader is the header with the unique ID of a delivery, such as \"X-GitHub-Delivery\". A delivery with the same ID as an accepted delivery in the idempotency window is acknowledged without starting the workflow again.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idempotencyField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdempotencyField is the dotted path of the unique ID of a delivery in the JSON payload, such as \"event.id\". It is used if IdempotencyHeader is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idempotencyWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdempotencyWindow is how long a delivery ID is remembered, such as \"1h\". The default is 24h. Deliveries are not kept for more than 7 days.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"name", "description", "alias", "workflow", "headers", "secret", "validationHeader"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.WebhookRateLimit"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_While(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_WebhookRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"condition": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"maxLoops": {
+					"requests": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Requests is the number of deliveries accepted per period.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"steps": {
+					"period": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Step"),
-									},
-								},
-							},
+							Description: "Period is the period of the rate limit, such as \"1m\". The default is 1m.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Step"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Workflow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_WebhookResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "WebhookResponse is the response of a synchronous webhook if the workflow did not complete with output.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workflowExecutionID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_WebsiteCrawlingConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"urls": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_While(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"condition": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"maxLoops": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Step"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Step"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Workflow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"Metadata": {
 						SchemaProps: spec.SchemaProps{
@@ -4076,6 +4950,24 @@ func schema_obot_platform_obot_apiclient_types_WorkflowExecution(ref common.Refe
 							Format:  "",
 						},
 					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"output": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"outputObject": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
 					"error": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -4091,6 +4983,70 @@ func schema_obot_platform_obot_apiclient_types_WorkflowExecution(ref common.Refe
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_WorkflowExecutionApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stepID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_WorkflowExecutionDetail(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"WorkflowExecution": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.WorkflowExecution"),
+						},
+					},
+					"tokenUsage": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TokenUsage"),
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.WorkflowExecutionStep"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"WorkflowExecution"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.TokenUsage", "github.com/obot-platform/obot/apiclient/types.WorkflowExecution", "github.com/obot-platform/obot/apiclient/types.WorkflowExecutionStep"},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_WorkflowExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4119,6 +5075,119 @@ func schema_obot_platform_obot_apiclient_types_WorkflowExecutionList(ref common.
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_WorkflowExecutionResume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fromStep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromStep is the ID of the step to resume from. By default, the execution resumes from the steps that failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_WorkflowExecutionStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"runIDs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastRunID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"tokenUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenUsage is the tokens consumed by the runs of this step and all of its nested steps.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.TokenUsage"),
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.WorkflowExecutionStep"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time", "github.com/obot-platform/obot/apiclient/types.TokenUsage", "github.com/obot-platform/obot/apiclient/types.WorkflowExecutionStep"},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_WorkflowList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4358,6 +5427,20 @@ func schema_obot_platform_obot_apiclient_types_WorkflowManifest(ref common.Refer
 							Format:  "",
 						},
 					},
+					"outputSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputSchema is a JSON Schema the final output of the workflow must match.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the maximum duration of an execution of the workflow, such as \"30m\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "icons", "description", "default", "temperature", "cache", "alias", "prompt", "knowledgeDescription", "agents", "workflows", "tools", "availableThreadTools", "defaultThreadTools", "oauthApps", "maxThreadTools", "params", "model", "env", "steps", "output"},
 			},
@@ -4847,8 +5930,16 @@ func schema_storage_apis_ottootto8ai_v1_CronJobSpec(ref common.ReferenceCallback
 					},
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Schedule is a cron expression with optional seconds, such as \"0 9 * * 1-5\", or a descriptor such as \"@daily\" or \"@every 1h30m\". It can start with \"CRON_TZ=<timezone>\" to set its own timezone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the IANA timezone the schedule is evaluated in, such as \"America/New_York\". The default is the server's timezone.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"workflow": {
@@ -4868,6 +5959,34 @@ func schema_storage_apis_ottootto8ai_v1_CronJobSpec(ref common.ReferenceCallback
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Schedule"),
 						},
 					},
+					"concurrencyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrencyPolicy decides what happens when a run is due while an execution of the cron job is still running. The default is Allow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startingDeadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartingDeadline is how late a run can start after its scheduled time, such as \"10m\". Later runs are skipped. By default, late runs always start.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"catchUp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatchUp starts a run for each scheduled time that was missed, such as while the server was down, instead of a single run for all of them. At most the 10 most recent missed runs are started.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"suspended": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspended stops scheduled runs. The runs missed while suspended are not caught up. It is changed with the suspend and resume actions, and updates of the cron job keep its current value.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"threadName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -4888,6 +6007,12 @@ func schema_storage_apis_ottootto8ai_v1_CronJobStatus(ref common.ReferenceCallba
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
+					"lastScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScheduleTime is the scheduled time of the last run that was started or skipped.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 					"lastRunStartedAt": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
@@ -5183,35 +6308,122 @@ func schema_storage_apis_ottootto8ai_v1_EmailReceiverSpec(ref common.ReferenceCa
 							},
 						},
 					},
-					"threadName": {
+					"authenticationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthenticationPolicy decides what happens to email that is not authenticated by SPF or DKIM aligned with the domain of its From header. The default is flag.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suspended": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspended stops the receiver from starting its workflow. Received emails are dropped. It is changed with the suspend and resume actions, and updates of the receiver keep its current value.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"maxAttachmentSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAttachmentSize is the largest attachment, in bytes, that is written to the workspace of the workflow execution. Larger attachments are listed in the input but not written. The default is 10MB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxTotalAttachmentSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxTotalAttachmentSize is the most bytes of attachments that are written for one email. The default is 25MB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"replyWithOutput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplyWithOutput sends the output of the workflow back to the sender when the execution completes. Replies to that email continue the same thread. The reply goes to the From header address, and only if the email is authenticated and that address is an allowed sender. The server must be configured with an outbound SMTP relay.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"threadName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name", "description", "workflow"},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_EmailReceiverStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"aliasAssigned": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"aliasProcessed": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
 				},
-				Required: []string{"name", "description", "workflow"},
 			},
 		},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_EmailReceiverStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_EmailReply(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"aliasAssigned": {
+					"from": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Description: "From is the address of the email receiver the email was sent to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"aliasProcessed": {
+					"subject": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"inReplyTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InReplyTo is the Message-ID of the email that is replied to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"references": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
@@ -5440,31 +6652,246 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeFileStatus(ref common.Reference
 							},
 						},
 					},
-					"lastIngestionStartTime": {
+					"lastIngestionStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastIngestionEndTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"ingestGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetSpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSet"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSetManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KnowledgeSetManifest should be moved to types once we expose this API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dataDescription": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetManifest"),
+						},
+					},
+					"agentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AgentName is the name of the agent that created and owns this knowledge set",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workflowName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkflowName is the name of the workflow that created and owns this knowledge set",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"threadName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThreadName is the name of the thread that created and owns this knowledge set",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"textEmbeddingModel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TextEmbeddingModel is set when the model is predetermined on creation. For example, agent threads.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetManifest"},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hasContent": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"emptyDatasetDeleted": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"suggestedDataDescription": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workspaceName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"threadName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"lastIngestionEndTime": {
+					"existingFile": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"ingestGeneration": {
+					"textEmbeddingModel": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -5493,24 +6920,24 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSet(ref common.ReferenceCallbac
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetSpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceSpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSourceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -5543,7 +6970,7 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSetList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSet"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSource"),
 									},
 								},
 							},
@@ -5554,30 +6981,11 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSetList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSetManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "KnowledgeSetManifest should be moved to types once we expose this API",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"dataDescription": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-				},
-			},
-		},
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSource", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -5586,100 +6994,107 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSetSpec(ref common.ReferenceCal
 					"manifest": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetManifest"),
-						},
-					},
-					"agentName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AgentName is the name of the agent that created and owns this knowledge set",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"workflowName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "WorkflowName is the name of the workflow that created and owns this knowledge set",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.KnowledgeSourceManifest"),
 						},
 					},
-					"threadName": {
+					"knowledgeSetName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ThreadName is the name of the thread that created and owns this knowledge set",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"textEmbeddingModel": {
+					"syncGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TextEmbeddingModel is set when the model is predetermined on creation. For example, agent threads.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSetManifest"},
+			"github.com/obot-platform/obot/apiclient/types.KnowledgeSourceManifest"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"hasContent": {
+					"workspaceName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"emptyDatasetDeleted": {
+					"threadName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"suggestedDataDescription": {
+					"runName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"workspaceName": {
+					"syncState": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"threadName": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"existingFile": {
+					"syncDetails": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "",
+							Format: "byte",
 						},
 					},
-					"textEmbeddingModel": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
+					"syncGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"lastSyncStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSyncEndTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -5708,24 +7123,24 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSource(ref common.ReferenceCall
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummarySpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummaryStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceSpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummarySpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummaryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSourceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSummaryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -5758,7 +7173,7 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSourceList(ref common.Reference
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSource"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummary"),
 									},
 								},
 							},
@@ -5769,124 +7184,194 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSourceList(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSource", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummary", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSummarySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"manifest": {
+					"threadName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.KnowledgeSourceManifest"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"knowledgeSetName": {
+					"contentHash": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"syncGeneration": {
+					"summary": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Type:   []string{"string"},
+							Format: "byte",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.KnowledgeSourceManifest"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_KnowledgeSummaryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_Model(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"workspaceName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"threadName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"runName": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"syncState": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelStatus"),
 						},
 					},
-					"syncDetails": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelSpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_ModelList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Model"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Model", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_ModelSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manifest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelManifest"),
 						},
 					},
-					"error": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ModelManifest"},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_ModelStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"aliasAssigned": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"syncGeneration": {
+					"aliasProcessed": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
 							Format: "int64",
 						},
 					},
-					"lastSyncStartTime": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastSyncEndTime": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"nextSyncTime": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_NotificationDelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "NotificationDelivery is a notification of an event that is sent to a NotificationTarget until it succeeds or runs out of attempts.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -5911,24 +7396,24 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSummary(ref common.ReferenceCal
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummarySpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationDeliverySpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummaryStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationDeliveryStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummarySpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummaryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationDeliverySpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationDeliveryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSummaryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_NotificationDeliveryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -5961,7 +7446,7 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSummaryList(ref common.Referenc
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummary"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationDelivery"),
 									},
 								},
 							},
@@ -5972,32 +7457,40 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSummaryList(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.KnowledgeSummary", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationDelivery", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSummarySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_NotificationDeliverySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"threadName": {
+					"notificationTargetName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"contentHash": {
+					"event": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"summary": {
+					"sourceName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Description: "SourceName is the name of the workflow execution or run the event is for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the JSON body of the notification. It is built once so that every attempt sends the same body.",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
 				},
@@ -6006,17 +7499,56 @@ func schema_storage_apis_ottootto8ai_v1_KnowledgeSummarySpec(ref common.Referenc
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_KnowledgeSummaryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_NotificationDeliveryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"lastAttemptAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deliveredAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"statusCode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is true once the notification is not going to be retried.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_Model(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_NotificationTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -6045,24 +7577,24 @@ func schema_storage_apis_ottootto8ai_v1_Model(ref common.ReferenceCallback) comm
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationTargetSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationTargetStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelSpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ModelStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationTargetSpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationTargetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_ModelList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_NotificationTargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -6095,7 +7627,7 @@ func schema_storage_apis_ottootto8ai_v1_ModelList(ref common.ReferenceCallback)
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Model"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationTarget"),
 									},
 								},
 							},
@@ -6106,51 +7638,97 @@ func schema_storage_apis_ottootto8ai_v1_ModelList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.Model", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.NotificationTarget", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_ModelSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_NotificationTargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"manifest": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelManifest"),
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is where notifications are sent with a POST request.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers are added to each notification request.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret signs notifications. The X-Obot-Signature header is \"sha256=\" and the hex HMAC-SHA256 of \"<timestamp>.<body>\", where the timestamp is the X-Obot-Timestamp header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events are the events sent to the target. All events are sent if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"url"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ModelManifest"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_ModelStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_NotificationTargetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"aliasAssigned": {
+					"lastDeliveryAt": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"aliasProcessed": {
+					"lastDeliveryError": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6667,6 +8245,13 @@ func schema_storage_apis_ottootto8ai_v1_RunSpec(ref common.ReferenceCallback) co
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"abort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Abort cancels the run without aborting the other runs in its thread.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"input"},
 			},
@@ -6794,90 +8379,187 @@ func schema_storage_apis_ottootto8ai_v1_RunStateSpec(ref common.ReferenceCallbac
 							Format: "byte",
 						},
 					},
-					"callFrame": {
+					"callFrame": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"output": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"done": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_RunStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"output": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"subCall": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Ref: ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.SubCall"),
 						},
 					},
-					"output": {
+					"tokenUsage": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TokenUsage"),
 						},
 					},
-					"done": {
+					"needsAuth": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "NeedsAuth is true once the run has asked the user to log in to a tool, and AuthURL is the login URL if there is one.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"error": {
+					"authURL": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
+				Required: []string{"output"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.TokenUsage", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.SubCall", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_RunStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_StepApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
+					"token": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
-									},
-								},
-							},
+							Description: "Token authorizes a decision made through the approval link instead of the authenticated API.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"state": {
+					"decision": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"output": {
+					"approver": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"endTime": {
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"time": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"error": {
+					"notifiedApprovers": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "NotifiedApprovers are the approvers the approval link was sent to. The others are retried with backoff.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"subCall": {
+					"notificationAttempts": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.SubCall"),
+							Description: "NotificationAttempts is how many times sending the approval link to the approvers that are left has been tried.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastNotificationAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"notificationError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotificationError is set if the approval link could not be sent to some of the approvers.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"output"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.SubCall", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -7361,55 +9043,251 @@ func schema_storage_apis_ottootto8ai_v1_ToolReferenceStatus(ref common.Reference
 					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"lastReferenceCheck": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"tool": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolShortDescription"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolShortDescription", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_ToolShortDescription(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"params": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"credential": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_Webhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookSpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_WebhookDelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookDelivery is a record of a request received by a webhook.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookDeliverySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmptyStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmptyStatus", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookDeliverySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_ottootto8ai_v1_WebhookDeliveryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastReferenceCheck": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tool": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolShortDescription"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"error": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookDelivery"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.ToolShortDescription", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookDelivery", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_ottootto8ai_v1_ToolShortDescription(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_ottootto8ai_v1_WebhookDeliverySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"description": {
+					"webhookName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"params": {
+					"headers": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "Headers are the headers of the request selected by the webhook.",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -7422,76 +9300,66 @@ func schema_storage_apis_ottootto8ai_v1_ToolShortDescription(ref common.Referenc
 							},
 						},
 					},
-					"metadata": {
+					"bodySize": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"credential": {
+					"bodySHA256": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_storage_apis_ottootto8ai_v1_Webhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"body": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Body is the gzip compressed body of the request. It is not kept for large bodies, and those deliveries can not be replayed.",
 							Type:        []string{"string"},
-							Format:      "",
+							Format:      "byte",
 						},
 					},
-					"apiVersion": {
+					"result": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"metadata": {
+					"statusCode": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"spec": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookSpec"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"status": {
+					"workflowExecutionName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookStatus"),
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"idempotencyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdempotencyKey is the unique ID of the delivery from the webhook's idempotency header or field.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replayOf": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplayOf is the name of the delivery this delivery replayed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookSpec", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.WebhookStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -7605,6 +9473,105 @@ func schema_storage_apis_ottootto8ai_v1_WebhookSpec(ref common.ReferenceCallback
 							Format:  "",
 						},
 					},
+					"suspended": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspended stops the webhook from starting its workflow. Deliveries get 503 Service Unavailable. It is changed with the suspend and resume actions, and updates of the webhook keep its current value.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"signatureScheme": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureScheme is how deliveries are signed with the secret. The default is an HMAC-SHA256 of the body in the validation header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"signatureEncoding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureEncoding is the encoding of the signature for the hmac and timestampedHMAC schemes. The default is hex.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestampHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimestampHeader is the header with the delivery timestamp for the timestampedHMAC scheme.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replayWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplayWindow is how old the timestamp of a signed delivery can be, such as \"5m\". The default is 5m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter is a CEL expression evaluated against the \"payload\" and \"headers\" of a delivery. Deliveries that do not match are accepted without starting the workflow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mapping maps the fields of the workflow input to CEL expressions evaluated against the \"payload\" and \"headers\" of a delivery. If set, the workflow input is only the mapped fields instead of the whole delivery.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"synchronous": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Synchronous makes the webhook wait for the workflow to finish and respond with its output.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"synchronousTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SynchronousTimeout is the longest a synchronous webhook waits for the workflow, such as \"30s\". If the workflow has not finished by then, the webhook responds with 202 Accepted. The default is 30s.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits how often the webhook accepts deliveries. Deliveries over the limit get 429 Too Many Requests.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.WebhookRateLimit"),
+						},
+					},
+					"idempotencyHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdempotencyHeader is the header with the unique ID of a delivery, such as \"X-GitHub-Delivery\". A delivery with the same ID as an accepted delivery in the idempotency window is acknowledged without starting the workflow again.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idempotencyField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdempotencyField is the dotted path of the unique ID of a delivery in the JSON payload, such as \"event.id\". It is used if IdempotencyHeader is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idempotencyWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdempotencyWindow is how long a delivery ID is remembered, such as \"1h\". The default is 24h. Deliveries are not kept for more than 7 days.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"tokenHash": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -7622,6 +9589,8 @@ func schema_storage_apis_ottootto8ai_v1_WebhookSpec(ref common.ReferenceCallback
 				Required: []string{"name", "description", "alias", "workflow", "headers", "secret", "validationHeader", "ThreadName"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.WebhookRateLimit"},
 	}
 }
 
@@ -7881,9 +9850,24 @@ func schema_storage_apis_ottootto8ai_v1_WorkflowExecutionSpec(ref common.Referen
 							Format: "",
 						},
 					},
+					"emailMessageID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmailMessageID is the Message-ID of the latest email that started or continued the execution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"emailReply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmailReply is set if the output of the execution is sent back to the sender of the email.",
+							Ref:         ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReply"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.EmailReply"},
 	}
 }
 
@@ -7905,6 +9889,20 @@ func schema_storage_apis_ottootto8ai_v1_WorkflowExecutionStatus(ref common.Refer
 							Format: "",
 						},
 					},
+					"lastRunName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRunName is the run that produced the output, which has the complete output if Output is truncated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"outputObject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputObject is the parsed output of the workflow if the workflow declares an output schema.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
 					"error": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -7922,6 +9920,12 @@ func schema_storage_apis_ottootto8ai_v1_WorkflowExecutionStatus(ref common.Refer
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.WorkflowManifest"),
 						},
 					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is when the current generation of the workflow started running, which is what the timeout is measured from.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 					"endTime": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
@@ -7933,6 +9937,31 @@ func schema_storage_apis_ottootto8ai_v1_WorkflowExecutionStatus(ref common.Refer
 							Format: "int64",
 						},
 					},
+					"emailReplyGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmailReplyGeneration is the workflow generation the output was last emailed for, or gave up being emailed for.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"emailReplyError": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"emailReplyAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmailReplyAttempts is how many times sending the reply for the current generation has failed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"emailReplyLastAttemptAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
@@ -8296,11 +10325,44 @@ func schema_storage_apis_ottootto8ai_v1_WorkflowStepStatus(ref common.ReferenceC
 							Format: "",
 						},
 					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is the number of failed attempts that were retried.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"attemptRunOffset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttemptRunOffset is the index in RunNames of the first run of the current attempt. The runs of retried attempts are kept before it.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"outputSchemaCorrections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputSchemaCorrections is the number of runs of the current attempt that asked the model to correct output that did not match the output schema.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval is set for approval steps once they are waiting for a decision.",
+							Ref:         ref("github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.StepApproval"),
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is when the step started running, which is what the step timeout is measured from.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.SubCall"},
+			"github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.StepApproval", "github.com/obot-platform/obot/pkg/storage/apis/otto.otto8.ai/v1.SubCall", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -8448,6 +10510,13 @@ func schema_storage_apis_ottootto8ai_v1_WorkspaceSpec(ref common.ReferenceCallba
 							},
 						},
 					},
+					"workflowExecutionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkflowExecutionName is set for a workspace that is created for the input of a single workflow execution, such as the attachments of an email.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3,23 +3,26 @@ package system
 import "strings"
 
 const (
-	ThreadPrefix            = "t1"
-	AgentPrefix             = "a1"
-	RunPrefix               = "r1"
-	WorkflowPrefix          = "w1"
-	WorkflowExecutionPrefix = "we1"
-	WorkflowStepPrefix      = "ws1"
-	WorkspacePrefix         = "wksp1"
-	WebhookPrefix           = "wh1"
-	CronJobPrefix           = "cj1"
-	KnowledgeSourcePrefix   = "ks1"
-	OAuthAppPrefix          = "oa1"
-	KnowledgeSetPrefix      = "kst1"
-	OAuthAppLoginPrefix     = "oal1"
-	EmailReceiverPrefix     = "er1"
-	ModelPrefix             = "m1"
-	AliasPrefix             = "al1"
-	DefaultModelAliasPrefix = "dma1"
+	ThreadPrefix               = "t1"
+	AgentPrefix                = "a1"
+	RunPrefix                  = "r1"
+	WorkflowPrefix             = "w1"
+	WorkflowExecutionPrefix    = "we1"
+	WorkflowStepPrefix         = "ws1"
+	WorkspacePrefix            = "wksp1"
+	WebhookPrefix              = "wh1"
+	WebhookDeliveryPrefix      = "whd1"
+	CronJobPrefix              = "cj1"
+	NotificationTargetPrefix   = "nt1"
+	NotificationDeliveryPrefix = "ntd1"
+	KnowledgeSourcePrefix      = "ks1"
+	OAuthAppPrefix             = "oa1"
+	KnowledgeSetPrefix         = "kst1"
+	OAuthAppLoginPrefix        = "oal1"
+	EmailReceiverPrefix        = "er1"
+	ModelPrefix                = "m1"
+	AliasPrefix                = "al1"
+	DefaultModelAliasPrefix    = "dma1"
 )
 
 func IsThreadID(id string) bool {
@@ -45,3 +48,7 @@ func IsWorkflowID(id string) bool {
 func IsEmailReceiverID(id string) bool {
 	return strings.HasPrefix(id, EmailReceiverPrefix)
 }
+
+func IsCronJobID(id string) bool {
+	return strings.HasPrefix(id, CronJobPrefix)
+}